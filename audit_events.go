@@ -1,8 +1,11 @@
 package gitlab
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -202,3 +205,399 @@ func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, op
 
 	return ae, resp, nil
 }
+
+// AuditEventStreamingDestination represents an audit event streaming
+// destination of the instance or a group. Streaming destinations are managed
+// using the GraphQL API, so their IDs are global IDs like
+// "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#instanceexternalauditeventdestination
+type AuditEventStreamingDestination struct {
+	ID                string                       `json:"id"`
+	Name              string                       `json:"name"`
+	DestinationURL    string                       `json:"destinationUrl"`
+	VerificationToken string                       `json:"verificationToken"`
+	EventTypeFilters  []string                     `json:"eventTypeFilters"`
+	Headers           []*AuditEventStreamingHeader `json:"headers"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The GraphQL API
+// returns the headers as a connection, of which the nodes are stored in
+// Headers.
+func (d *AuditEventStreamingDestination) UnmarshalJSON(data []byte) error {
+	type alias AuditEventStreamingDestination
+
+	raw := struct {
+		*alias
+		Headers struct {
+			Nodes []*AuditEventStreamingHeader `json:"nodes"`
+		} `json:"headers"`
+	}{
+		alias: (*alias)(d),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	d.Headers = raw.Headers.Nodes
+
+	return nil
+}
+
+// AuditEventStreamingHeader represents a custom HTTP header sent with every
+// event streamed to an audit event streaming destination.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#auditeventstreaminginstanceheader
+type AuditEventStreamingHeader struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Active bool   `json:"active"`
+}
+
+// auditEventStreamingDestinationFields are the fields requested for each
+// audit event streaming destination.
+const auditEventStreamingDestinationFields = "id name destinationUrl verificationToken eventTypeFilters headers { nodes { id key value active } }"
+
+// auditEventStreamingHeaderFields are the fields requested for each audit
+// event streaming header.
+const auditEventStreamingHeaderFields = "id key value active"
+
+// auditEventStreamingDestinations represents a page of audit event streaming
+// destinations returned by the GraphQL API.
+type auditEventStreamingDestinations struct {
+	Nodes    []*AuditEventStreamingDestination `json:"nodes"`
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+// listAuditEventStreamingDestinations gets all audit event streaming
+// destinations, by requesting pages using page until there are no more.
+func listAuditEventStreamingDestinations(page func(after *string) (*auditEventStreamingDestinations, *Response, error)) ([]*AuditEventStreamingDestination, *Response, error) {
+	var ds []*AuditEventStreamingDestination
+	var after *string
+
+	for {
+		p, resp, err := page(after)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		ds = append(ds, p.Nodes...)
+
+		if !p.PageInfo.HasNextPage || p.PageInfo.EndCursor == "" {
+			return ds, resp, nil
+		}
+		after = &p.PageInfo.EndCursor
+	}
+}
+
+// mutateAuditEventStreaming runs the audit event streaming mutation with the
+// given input. When result is set, the result field of the payload is
+// requested with the given fields and decoded into v.
+func (s *AuditEventsService) mutateAuditEventStreaming(mutation string, input interface{}, result, fields string, v interface{}, options []RequestOptionFunc) (*Response, error) {
+	// The input type of a mutation is named after the mutation, so the input
+	// of "externalAuditEventDestinationCreate" is of the type
+	// "ExternalAuditEventDestinationCreateInput".
+	inputType := strings.ToUpper(mutation[:1]) + mutation[1:] + "Input"
+
+	selection := "errors"
+	if result != "" {
+		selection += " " + result + " { " + fields + " }"
+	}
+	query := fmt.Sprintf("mutation($input: %s!) { %s(input: $input) { %s } }", inputType, mutation, selection)
+
+	var out map[string]map[string]json.RawMessage
+	resp, err := s.client.GraphQL.query(context.Background(), query, map[string]interface{}{"input": input}, &out, options)
+	if err != nil {
+		return resp, err
+	}
+
+	payload := out[mutation]
+
+	var errs []string
+	if e, ok := payload["errors"]; ok {
+		if err := json.Unmarshal(e, &errs); err != nil {
+			return resp, err
+		}
+	}
+	if err := mutationErrors(errs); err != nil {
+		return resp, err
+	}
+
+	if r, ok := payload[result]; ok && v != nil {
+		if err := json.Unmarshal(r, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// ListInstanceAuditEventStreamingDestinations gets all audit event streaming
+// destinations of the instance. Authentication as Administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryinstanceexternalauditeventdestinations
+func (s *AuditEventsService) ListInstanceAuditEventStreamingDestinations(options ...RequestOptionFunc) ([]*AuditEventStreamingDestination, *Response, error) {
+	const query = "query($after: String) { instanceExternalAuditEventDestinations(after: $after) { nodes { " +
+		auditEventStreamingDestinationFields + " } pageInfo { hasNextPage endCursor } } }"
+
+	return listAuditEventStreamingDestinations(func(after *string) (*auditEventStreamingDestinations, *Response, error) {
+		var out struct {
+			Destinations auditEventStreamingDestinations `json:"instanceExternalAuditEventDestinations"`
+		}
+		resp, err := s.client.GraphQL.query(context.Background(), query, map[string]interface{}{"after": after}, &out, options)
+		return &out.Destinations, resp, err
+	})
+}
+
+// CreateAuditEventStreamingDestinationOptions represents the available
+// CreateInstanceAuditEventStreamingDestination() and
+// CreateGroupAuditEventStreamingDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationcreate
+type CreateAuditEventStreamingDestinationOptions struct {
+	Name           *string `json:"name,omitempty"`
+	DestinationURL *string `json:"destinationUrl,omitempty"`
+}
+
+// CreateInstanceAuditEventStreamingDestination creates a new audit event
+// streaming destination for the instance. Authentication as Administrator is
+// required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationcreate
+func (s *AuditEventsService) CreateInstanceAuditEventStreamingDestination(opt *CreateAuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	d := new(AuditEventStreamingDestination)
+	resp, err := s.mutateAuditEventStreaming(
+		"instanceExternalAuditEventDestinationCreate", opt,
+		"instanceExternalAuditEventDestination", auditEventStreamingDestinationFields, d, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// UpdateAuditEventStreamingDestinationOptions represents the available
+// UpdateInstanceAuditEventStreamingDestination() and
+// UpdateGroupAuditEventStreamingDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationupdate
+type UpdateAuditEventStreamingDestinationOptions struct {
+	Name           *string `json:"name,omitempty"`
+	DestinationURL *string `json:"destinationUrl,omitempty"`
+}
+
+// UpdateInstanceAuditEventStreamingDestination updates an existing audit event
+// streaming destination of the instance. Authentication as Administrator is
+// required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationupdate
+func (s *AuditEventsService) UpdateInstanceAuditEventStreamingDestination(destination string, opt *UpdateAuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := struct {
+		ID string `json:"id"`
+		*UpdateAuditEventStreamingDestinationOptions
+	}{destination, opt}
+
+	d := new(AuditEventStreamingDestination)
+	resp, err := s.mutateAuditEventStreaming(
+		"instanceExternalAuditEventDestinationUpdate", input,
+		"instanceExternalAuditEventDestination", auditEventStreamingDestinationFields, d, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// DeleteInstanceAuditEventStreamingDestination deletes an audit event
+// streaming destination of the instance. Authentication as Administrator is
+// required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationdestroy
+func (s *AuditEventsService) DeleteInstanceAuditEventStreamingDestination(destination string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"id": destination}
+	return s.mutateAuditEventStreaming("instanceExternalAuditEventDestinationDestroy", input, "", "", nil, options)
+}
+
+// CreateAuditEventStreamingHeaderOptions represents the available
+// CreateInstanceAuditEventStreamingHeader() and
+// CreateGroupAuditEventStreamingHeader() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreaminginstanceheaderscreate
+type CreateAuditEventStreamingHeaderOptions struct {
+	Key    *string `json:"key,omitempty"`
+	Value  *string `json:"value,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+}
+
+// CreateInstanceAuditEventStreamingHeader adds a custom HTTP header to an
+// audit event streaming destination of the instance. Authentication as
+// Administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreaminginstanceheaderscreate
+func (s *AuditEventsService) CreateInstanceAuditEventStreamingHeader(destination string, opt *CreateAuditEventStreamingHeaderOptions, options ...RequestOptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := struct {
+		DestinationID string `json:"destinationId"`
+		*CreateAuditEventStreamingHeaderOptions
+	}{destination, opt}
+
+	h := new(AuditEventStreamingHeader)
+	resp, err := s.mutateAuditEventStreaming(
+		"auditEventsStreamingInstanceHeadersCreate", input,
+		"header", auditEventStreamingHeaderFields, h, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// DeleteInstanceAuditEventStreamingHeader deletes a custom HTTP header of an
+// audit event streaming destination of the instance. Authentication as
+// Administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreaminginstanceheadersdestroy
+func (s *AuditEventsService) DeleteInstanceAuditEventStreamingHeader(header string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"headerId": header}
+	return s.mutateAuditEventStreaming("auditEventsStreamingInstanceHeadersDestroy", input, "", "", nil, options)
+}
+
+// ListGroupAuditEventStreamingDestinations gets all audit event streaming
+// destinations of a group, identified by its full path. Authentication as a
+// group owner is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#groupexternalauditeventdestinations
+func (s *AuditEventsService) ListGroupAuditEventStreamingDestinations(group string, options ...RequestOptionFunc) ([]*AuditEventStreamingDestination, *Response, error) {
+	const query = "query($fullPath: ID!, $after: String) { group(fullPath: $fullPath) { externalAuditEventDestinations(after: $after) { nodes { " +
+		auditEventStreamingDestinationFields + " } pageInfo { hasNextPage endCursor } } } }"
+
+	return listAuditEventStreamingDestinations(func(after *string) (*auditEventStreamingDestinations, *Response, error) {
+		var out struct {
+			Group *struct {
+				Destinations auditEventStreamingDestinations `json:"externalAuditEventDestinations"`
+			} `json:"group"`
+		}
+		vars := map[string]interface{}{"fullPath": group, "after": after}
+		resp, err := s.client.GraphQL.query(context.Background(), query, vars, &out, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		// The GraphQL API returns no group when it does not exist, or when
+		// it is not visible to the user.
+		if out.Group == nil {
+			return nil, resp, ErrNotFound
+		}
+		return &out.Group.Destinations, resp, nil
+	})
+}
+
+// CreateGroupAuditEventStreamingDestination creates a new audit event
+// streaming destination for a group, identified by its full path.
+// Authentication as a group owner is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationcreate
+func (s *AuditEventsService) CreateGroupAuditEventStreamingDestination(group string, opt *CreateAuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := struct {
+		GroupPath string `json:"groupPath"`
+		*CreateAuditEventStreamingDestinationOptions
+	}{group, opt}
+
+	d := new(AuditEventStreamingDestination)
+	resp, err := s.mutateAuditEventStreaming(
+		"externalAuditEventDestinationCreate", input,
+		"externalAuditEventDestination", auditEventStreamingDestinationFields, d, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// UpdateGroupAuditEventStreamingDestination updates an existing audit event
+// streaming destination of a group. Authentication as a group owner is
+// required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationupdate
+func (s *AuditEventsService) UpdateGroupAuditEventStreamingDestination(destination string, opt *UpdateAuditEventStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := struct {
+		ID string `json:"id"`
+		*UpdateAuditEventStreamingDestinationOptions
+	}{destination, opt}
+
+	d := new(AuditEventStreamingDestination)
+	resp, err := s.mutateAuditEventStreaming(
+		"externalAuditEventDestinationUpdate", input,
+		"externalAuditEventDestination", auditEventStreamingDestinationFields, d, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// DeleteGroupAuditEventStreamingDestination deletes an audit event streaming
+// destination of a group. Authentication as a group owner is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationdestroy
+func (s *AuditEventsService) DeleteGroupAuditEventStreamingDestination(destination string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"id": destination}
+	return s.mutateAuditEventStreaming("externalAuditEventDestinationDestroy", input, "", "", nil, options)
+}
+
+// CreateGroupAuditEventStreamingHeader adds a custom HTTP header to an audit
+// event streaming destination of a group. Authentication as a group owner is
+// required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheaderscreate
+func (s *AuditEventsService) CreateGroupAuditEventStreamingHeader(destination string, opt *CreateAuditEventStreamingHeaderOptions, options ...RequestOptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := struct {
+		DestinationID string `json:"destinationId"`
+		*CreateAuditEventStreamingHeaderOptions
+	}{destination, opt}
+
+	h := new(AuditEventStreamingHeader)
+	resp, err := s.mutateAuditEventStreaming(
+		"auditEventsStreamingHeadersCreate", input,
+		"header", auditEventStreamingHeaderFields, h, options,
+	)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// DeleteGroupAuditEventStreamingHeader deletes a custom HTTP header of an
+// audit event streaming destination of a group. Authentication as a group
+// owner is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsstreamingheadersdestroy
+func (s *AuditEventsService) DeleteGroupAuditEventStreamingHeader(header string, options ...RequestOptionFunc) (*Response, error) {
+	input := map[string]interface{}{"headerId": header}
+	return s.mutateAuditEventStreaming("auditEventsStreamingHeadersDestroy", input, "", "", nil, options)
+}
@@ -1,8 +1,10 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -402,3 +404,286 @@ func TestAuditEventsService_GetProjectAuditEvent(t *testing.T) {
 	require.Nil(t, ae)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+// decodeGraphQLRequest decodes the body of a GraphQL request.
+func decodeGraphQLRequest(t *testing.T, r *http.Request) (query string, vars map[string]interface{}) {
+	t.Helper()
+
+	var body struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+	return body.Query, body.Variables
+}
+
+func TestAuditEventsService_ListInstanceAuditEventStreamingDestinations(t *testing.T) {
+	mux, client := setup(t)
+
+	var cursors []interface{}
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		require.Contains(t, query, "instanceExternalAuditEventDestinations(after: $after)")
+		cursors = append(cursors, vars["after"])
+
+		if vars["after"] == nil {
+			fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinations": {
+				"nodes": [{
+					"id": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1",
+					"name": "SIEM",
+					"destinationUrl": "https://siem.example.com/audit",
+					"verificationToken": "Jk3GFsbCtiVJCUaANmzbZ5FH",
+					"eventTypeFilters": ["user_created", "project_deleted"],
+					"headers": {"nodes": [{"id": "gid://gitlab/AuditEvents::Streaming::InstanceHeader/1", "key": "X-Api-Key", "value": "secret", "active": true}]}
+				}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "MQ"}
+			}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinations": {
+			"nodes": [{
+				"id": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/2",
+				"name": "Archive",
+				"destinationUrl": "https://archive.example.com/audit",
+				"verificationToken": "a8Hx1wUw6hdLgkgQ9VGn3y8X",
+				"eventTypeFilters": [],
+				"headers": {"nodes": []}
+			}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "Mg"}
+		}}}`)
+	})
+
+	ds, resp, err := client.AuditEvents.ListInstanceAuditEventStreamingDestinations()
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	want := []*AuditEventStreamingDestination{
+		{
+			ID:                "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1",
+			Name:              "SIEM",
+			DestinationURL:    "https://siem.example.com/audit",
+			VerificationToken: "Jk3GFsbCtiVJCUaANmzbZ5FH",
+			EventTypeFilters:  []string{"user_created", "project_deleted"},
+			Headers: []*AuditEventStreamingHeader{{
+				ID:     "gid://gitlab/AuditEvents::Streaming::InstanceHeader/1",
+				Key:    "X-Api-Key",
+				Value:  "secret",
+				Active: true,
+			}},
+		},
+		{
+			ID:                "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/2",
+			Name:              "Archive",
+			DestinationURL:    "https://archive.example.com/audit",
+			VerificationToken: "a8Hx1wUw6hdLgkgQ9VGn3y8X",
+			EventTypeFilters:  []string{},
+			Headers:           []*AuditEventStreamingHeader{},
+		},
+	}
+	require.Equal(t, want, ds)
+	require.Equal(t, []interface{}{nil, "MQ"}, cursors)
+}
+
+func TestAuditEventsService_CreateInstanceAuditEventStreamingDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		require.Contains(t, query, "mutation($input: InstanceExternalAuditEventDestinationCreateInput!) { instanceExternalAuditEventDestinationCreate(input: $input)")
+		require.Equal(t, map[string]interface{}{
+			"name":           "SIEM",
+			"destinationUrl": "https://siem.example.com/audit",
+		}, vars["input"])
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationCreate": {
+			"errors": [],
+			"instanceExternalAuditEventDestination": {
+				"id": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/3",
+				"name": "SIEM",
+				"destinationUrl": "https://siem.example.com/audit",
+				"verificationToken": "Jk3GFsbCtiVJCUaANmzbZ5FH",
+				"eventTypeFilters": [],
+				"headers": {"nodes": []}
+			}
+		}}}`)
+	})
+
+	d, _, err := client.AuditEvents.CreateInstanceAuditEventStreamingDestination(&CreateAuditEventStreamingDestinationOptions{
+		Name:           Ptr("SIEM"),
+		DestinationURL: Ptr("https://siem.example.com/audit"),
+	})
+	require.NoError(t, err)
+
+	want := &AuditEventStreamingDestination{
+		ID:                "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/3",
+		Name:              "SIEM",
+		DestinationURL:    "https://siem.example.com/audit",
+		VerificationToken: "Jk3GFsbCtiVJCUaANmzbZ5FH",
+		EventTypeFilters:  []string{},
+		Headers:           []*AuditEventStreamingHeader{},
+	}
+	require.Equal(t, want, d)
+}
+
+func TestAuditEventsService_CreateInstanceAuditEventStreamingDestinationErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationCreate": {
+			"errors": ["Destination url is blocked"],
+			"instanceExternalAuditEventDestination": null
+		}}}`)
+	})
+
+	d, resp, err := client.AuditEvents.CreateInstanceAuditEventStreamingDestination(&CreateAuditEventStreamingDestinationOptions{
+		DestinationURL: Ptr("http://localhost/audit"),
+	})
+	require.EqualError(t, err, "graphql: Destination url is blocked")
+	require.Nil(t, d)
+	require.NotNil(t, resp)
+}
+
+func TestAuditEventsService_UpdateAndDeleteInstanceAuditEventStreamingDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	const id = "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/3"
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		switch {
+		case strings.Contains(query, "instanceExternalAuditEventDestinationUpdate"):
+			require.Equal(t, map[string]interface{}{"id": id, "name": "Renamed"}, vars["input"])
+			fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationUpdate": {
+				"errors": [],
+				"instanceExternalAuditEventDestination": {"id": "`+id+`", "name": "Renamed", "headers": {"nodes": []}}
+			}}}`)
+		case strings.Contains(query, "instanceExternalAuditEventDestinationDestroy"):
+			require.Equal(t, map[string]interface{}{"id": id}, vars["input"])
+			fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationDestroy": {"errors": []}}}`)
+		default:
+			t.Fatalf("Unexpected query: %s", query)
+		}
+	})
+
+	d, _, err := client.AuditEvents.UpdateInstanceAuditEventStreamingDestination(id, &UpdateAuditEventStreamingDestinationOptions{
+		Name: Ptr("Renamed"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", d.Name)
+
+	_, err = client.AuditEvents.DeleteInstanceAuditEventStreamingDestination(id)
+	require.NoError(t, err)
+}
+
+func TestAuditEventsService_CreateInstanceAuditEventStreamingHeader(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		require.Contains(t, query, "auditEventsStreamingInstanceHeadersCreate(input: $input) { errors header { id key value active } }")
+		require.Equal(t, map[string]interface{}{
+			"destinationId": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/3",
+			"key":           "X-Api-Key",
+			"value":         "secret",
+			"active":        true,
+		}, vars["input"])
+		fmt.Fprint(w, `{"data": {"auditEventsStreamingInstanceHeadersCreate": {
+			"errors": [],
+			"header": {"id": "gid://gitlab/AuditEvents::Streaming::InstanceHeader/1", "key": "X-Api-Key", "value": "secret", "active": true}
+		}}}`)
+	})
+
+	h, _, err := client.AuditEvents.CreateInstanceAuditEventStreamingHeader(
+		"gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/3",
+		&CreateAuditEventStreamingHeaderOptions{Key: Ptr("X-Api-Key"), Value: Ptr("secret"), Active: Ptr(true)},
+	)
+	require.NoError(t, err)
+
+	want := &AuditEventStreamingHeader{
+		ID:     "gid://gitlab/AuditEvents::Streaming::InstanceHeader/1",
+		Key:    "X-Api-Key",
+		Value:  "secret",
+		Active: true,
+	}
+	require.Equal(t, want, h)
+}
+
+func TestAuditEventsService_ListGroupAuditEventStreamingDestinations(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		require.Contains(t, query, "group(fullPath: $fullPath) { externalAuditEventDestinations(after: $after)")
+
+		if vars["fullPath"] != "my-group" {
+			fmt.Fprint(w, `{"data": {"group": null}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"group": {"externalAuditEventDestinations": {
+			"nodes": [{
+				"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+				"name": "SIEM",
+				"destinationUrl": "https://siem.example.com/audit",
+				"verificationToken": "Jk3GFsbCtiVJCUaANmzbZ5FH",
+				"eventTypeFilters": [],
+				"headers": {"nodes": []}
+			}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "MQ"}
+		}}}}`)
+	})
+
+	ds, _, err := client.AuditEvents.ListGroupAuditEventStreamingDestinations("my-group")
+	require.NoError(t, err)
+
+	want := []*AuditEventStreamingDestination{{
+		ID:                "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+		Name:              "SIEM",
+		DestinationURL:    "https://siem.example.com/audit",
+		VerificationToken: "Jk3GFsbCtiVJCUaANmzbZ5FH",
+		EventTypeFilters:  []string{},
+		Headers:           []*AuditEventStreamingHeader{},
+	}}
+	require.Equal(t, want, ds)
+
+	ds, _, err = client.AuditEvents.ListGroupAuditEventStreamingDestinations("unknown-group")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Nil(t, ds)
+}
+
+func TestAuditEventsService_CreateGroupAuditEventStreamingDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		query, vars := decodeGraphQLRequest(t, r)
+		require.Contains(t, query, "mutation($input: ExternalAuditEventDestinationCreateInput!) { externalAuditEventDestinationCreate(input: $input)")
+		require.Equal(t, map[string]interface{}{
+			"groupPath":      "my-group",
+			"destinationUrl": "https://siem.example.com/audit",
+		}, vars["input"])
+		fmt.Fprint(w, `{"data": {"externalAuditEventDestinationCreate": {
+			"errors": [],
+			"externalAuditEventDestination": {
+				"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/2",
+				"name": "Destination 1",
+				"destinationUrl": "https://siem.example.com/audit",
+				"verificationToken": "Jk3GFsbCtiVJCUaANmzbZ5FH",
+				"eventTypeFilters": [],
+				"headers": {"nodes": []}
+			}
+		}}}`)
+	})
+
+	d, _, err := client.AuditEvents.CreateGroupAuditEventStreamingDestination("my-group", &CreateAuditEventStreamingDestinationOptions{
+		DestinationURL: Ptr("https://siem.example.com/audit"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "gid://gitlab/AuditEvents::ExternalAuditEventDestination/2", d.ID)
+	require.Equal(t, "Destination 1", d.Name)
+}
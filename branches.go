@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // BranchesService handles communication with the branch related methods
@@ -85,6 +86,93 @@ func (s *BranchesService) ListBranches(pid interface{}, opts *ListBranchesOption
 	return b, resp, nil
 }
 
+// BranchWithProtection represents a GitLab branch together with the protected
+// branch rules which apply to it.
+type BranchWithProtection struct {
+	*Branch
+
+	// ProtectedBranches contains the protected branch rules matching the
+	// branch, either by name or by wildcard (e.g. "release/*"). It is empty
+	// for unprotected branches.
+	ProtectedBranches []*ProtectedBranch
+}
+
+// ListBranchesWithProtection gets a list of repository branches from a project
+// like ListBranches, and adds the protected branch rules which apply to each
+// branch. The protected branches are only requested (all pages of them) when
+// at least one of the listed branches is protected.
+//
+// The returned response is the response of the branches request, so it can be
+// used to paginate through the branches.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/branches.html#list-repository-branches
+// https://docs.gitlab.com/ee/api/protected_branches.html#list-protected-branches
+func (s *BranchesService) ListBranchesWithProtection(pid interface{}, opts *ListBranchesOptions, options ...RequestOptionFunc) ([]*BranchWithProtection, *Response, error) {
+	branches, resp, err := s.ListBranches(pid, opts, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// The caller's options are passed on to the protected branches requests,
+	// so they use the same context and credentials. Their pagination is reset
+	// and the pages are not limited by a WithMaxPages of the caller, as all
+	// protected branches are needed to match them.
+	protectedOptions := append(options[:len(options):len(options)], WithPage(1), WithPerPage(100), WithMaxPages(0))
+
+	var protected []*ProtectedBranch
+	for _, b := range branches {
+		if b.Protected {
+			protected, err = Collect(func(options ...RequestOptionFunc) ([]*ProtectedBranch, *Response, error) {
+				return s.client.ProtectedBranches.ListProtectedBranches(pid, nil, options...)
+			}, protectedOptions...)
+			if err != nil {
+				return nil, resp, err
+			}
+			break
+		}
+	}
+
+	result := make([]*BranchWithProtection, 0, len(branches))
+	for _, b := range branches {
+		bp := &BranchWithProtection{Branch: b}
+		for _, pb := range protected {
+			if matchesProtectedBranch(pb.Name, b.Name) {
+				bp.ProtectedBranches = append(bp.ProtectedBranches, pb)
+			}
+		}
+		result = append(result, bp)
+	}
+
+	return result, resp, nil
+}
+
+// matchesProtectedBranch reports whether the branch name matches the name of
+// a protected branch, which may contain "*" wildcards matching any sequence
+// of characters (including "/").
+func matchesProtectedBranch(pattern, name string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == name
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(name, part)
+		if idx < 0 {
+			return false
+		}
+		name = name[idx+len(part):]
+	}
+
+	return strings.HasSuffix(name, last)
+}
+
 // GetBranch gets a single project repository branch.
 //
 // GitLab API docs:
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -330,3 +331,166 @@ func TestBranchesService_DeleteMergedBranches(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestListBranchesWithProtection(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"name": "feature", "protected": false},
+			{"name": "main", "protected": true, "default": true},
+			{"name": "release/1.0", "protected": true}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testParams(t, r, "page=1&per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "name": "main", "allow_force_push": false}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id": 2, "name": "release/*", "allow_force_push": true}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	branches, _, err := client.Branches.ListBranchesWithProtection(1, nil)
+	require.NoError(t, err)
+
+	main := &ProtectedBranch{ID: 1, Name: "main"}
+	release := &ProtectedBranch{ID: 2, Name: "release/*", AllowForcePush: true}
+
+	want := []*BranchWithProtection{
+		{Branch: &Branch{Name: "feature"}},
+		{Branch: &Branch{Name: "main", Protected: true, Default: true}, ProtectedBranches: []*ProtectedBranch{main}},
+		{Branch: &Branch{Name: "release/1.0", Protected: true}, ProtectedBranches: []*ProtectedBranch{release}},
+	}
+	assert.Equal(t, want, branches)
+}
+
+func TestListBranchesWithProtectionPageOption(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2")
+		if got := r.Header.Get("SUDO"); got != "alice" {
+			t.Errorf("branches request SUDO header is %q, want %q", got, "alice")
+		}
+		fmt.Fprint(w, `[{"name": "main", "protected": true}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		// The page option of the branches request is overridden.
+		testParams(t, r, "page=1&per_page=100")
+		if got := r.Header.Get("SUDO"); got != "alice" {
+			t.Errorf("protected branches request SUDO header is %q, want %q", got, "alice")
+		}
+		fmt.Fprint(w, `[{"id": 1, "name": "main"}]`)
+	})
+
+	branches, _, err := client.Branches.ListBranchesWithProtection(1, nil, WithPage(2), WithSudo("alice"))
+	require.NoError(t, err)
+
+	want := []*BranchWithProtection{
+		{Branch: &Branch{Name: "main", Protected: true}, ProtectedBranches: []*ProtectedBranch{{ID: 1, Name: "main"}}},
+	}
+	assert.Equal(t, want, branches)
+}
+
+func TestListBranchesWithProtectionToken(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name": "main", "protected": true}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "secret" {
+			t.Errorf("protected branches request PRIVATE-TOKEN header is %q, want %q", got, "secret")
+		}
+		fmt.Fprint(w, `[{"id": 1, "name": "main"}]`)
+	})
+
+	_, _, err := client.Branches.ListBranchesWithProtection(1, nil, WithToken(PrivateToken, "secret"))
+	require.NoError(t, err)
+}
+
+func TestListBranchesWithProtectionMaxPages(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name": "main", "protected": true}, {"name": "release/1.0", "protected": true}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "name": "main"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id": 2, "name": "release/*"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	// The maximum number of pages of the caller does not limit the protected
+	// branches requests.
+	branches, _, err := client.Branches.ListBranchesWithProtection(1, nil, WithMaxPages(1))
+	require.NoError(t, err)
+
+	want := []*BranchWithProtection{
+		{Branch: &Branch{Name: "main", Protected: true}, ProtectedBranches: []*ProtectedBranch{{ID: 1, Name: "main"}}},
+		{Branch: &Branch{Name: "release/1.0", Protected: true}, ProtectedBranches: []*ProtectedBranch{{ID: 2, Name: "release/*"}}},
+	}
+	assert.Equal(t, want, branches)
+}
+
+func TestListBranchesWithProtectionUnprotected(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name": "feature", "protected": false}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("protected branches should not be requested")
+	})
+
+	branches, _, err := client.Branches.ListBranchesWithProtection(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*BranchWithProtection{{Branch: &Branch{Name: "feature"}}}, branches)
+}
+
+func TestMatchesProtectedBranch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"main", "main", true},
+		{"main", "main2", false},
+		{"release/*", "release/1.0", true},
+		{"release/*", "release/1.0/hotfix", true},
+		{"release/*", "releases/1.0", false},
+		{"*-stable", "15-0-stable", true},
+		{"*-stable", "15-0-stable-ee", false},
+		{"feature/*/wip", "feature/login/wip", true},
+		{"feature/*/wip", "feature/login", false},
+		{"a*b*b", "ab", false},
+		{"a*b*b", "abb", true},
+		{"*", "anything", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"_"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesProtectedBranch(tt.pattern, tt.name))
+		})
+	}
+}
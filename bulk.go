@@ -0,0 +1,114 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// bulkWorkers is the maximum number of concurrent requests used by bulk
+// methods like BulkUpdateIssues and MarkTodosAsDone.
+const bulkWorkers = 5
+
+// BulkError is returned by bulk methods like BulkUpdateIssues and
+// MarkTodosAsDone when one or more of the individual requests failed. Errors
+// are keyed by the ID (or IID) the request was made for.
+type BulkError struct {
+	Errors map[int]error
+
+	// action describes the failed requests in the error message, like
+	// "update issues".
+	action string
+}
+
+func (e *BulkError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, id := range e.sortedIDs() {
+		msgs = append(msgs, fmt.Sprintf("%d: %v", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("failed to %s (%d): %s", e.action, len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the individual requests ordered by ID.
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, id := range e.sortedIDs() {
+		errs = append(errs, e.Errors[id])
+	}
+	return errs
+}
+
+func (e *BulkError) sortedIDs() []int {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// bulkDo calls fn once for every distinct ID in ids, using at most
+// bulkWorkers concurrent calls. It returns the results of the successful
+// calls keyed by ID, and a *BulkError when one or more calls failed.
+func bulkDo[T any](ids []int, action string, fn func(id int) (T, error)) (map[int]T, error) {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	results := make([]T, len(unique))
+	errs := make([]error, len(unique))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < bulkWorkers && w < len(unique); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx], errs[idx] = fn(unique[idx])
+			}
+		}()
+	}
+	for idx := range unique {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	byID := make(map[int]T, len(unique))
+	bulkErr := &BulkError{Errors: make(map[int]error), action: action}
+	for idx, id := range unique {
+		if errs[idx] != nil {
+			bulkErr.Errors[id] = errs[idx]
+			continue
+		}
+		byID[id] = results[idx]
+	}
+	if len(bulkErr.Errors) > 0 {
+		return byID, bulkErr
+	}
+
+	return byID, nil
+}
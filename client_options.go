@@ -93,6 +93,16 @@ func WithCustomRetryWaitMinMax(waitMin, waitMax time.Duration) ClientOptionFunc
 	}
 }
 
+// WithDefaultAccessLevel sets the access level used by AddGroupMember and
+// AddProjectMember when the AccessLevel of the given options is not set. An
+// explicitly set access level always takes precedence.
+func WithDefaultAccessLevel(level AccessLevelValue) ClientOptionFunc {
+	return func(c *Client) error {
+		c.defaultAccessLevel = &level
+		return nil
+	}
+}
+
 // WithErrorHandler can be used to configure a custom error handler.
 func WithErrorHandler(handler retryablehttp.ErrorHandler) ClientOptionFunc {
 	return func(c *Client) error {
@@ -101,7 +111,8 @@ func WithErrorHandler(handler retryablehttp.ErrorHandler) ClientOptionFunc {
 	}
 }
 
-// WithHTTPClient can be used to configure a custom HTTP client.
+// WithHTTPClient can be used to configure a custom HTTP client. The client is
+// still wrapped by the retry logic of the library.
 func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	return func(c *Client) error {
 		c.client.HTTPClient = httpClient
@@ -109,6 +120,18 @@ func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	}
 }
 
+// WithHTTPTransport can be used to configure a custom HTTP transport, for
+// example to use a proxy or custom TLS settings, while keeping the default
+// HTTP client and the retry logic of the library. When combined with
+// WithHTTPClient, the transport replaces the transport of the given HTTP
+// client regardless of the order of the options.
+func WithHTTPTransport(rt http.RoundTripper) ClientOptionFunc {
+	return func(c *Client) error {
+		c.transport = rt
+		return nil
+	}
+}
+
 // WithRequestLogHook can be used to configure a custom request log hook.
 func WithRequestLogHook(hook retryablehttp.RequestLogHook) ClientOptionFunc {
 	return func(c *Client) error {
@@ -117,6 +140,28 @@ func WithRequestLogHook(hook retryablehttp.RequestLogHook) ClientOptionFunc {
 	}
 }
 
+// WithRequestHook can be used to configure a hook which is called once for
+// every API call, right before the request is sent. Unlike the request log
+// hook, it is not called again when a request is retried. The hook receives
+// a copy of the request, so changes made to it are not sent to GitLab.
+func WithRequestHook(hook func(*http.Request)) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestHook = hook
+		return nil
+	}
+}
+
+// WithResponseHook can be used to configure a hook which is called once for
+// every API call, after the final response is received. The hook receives the
+// total duration of the call including any retries. It is also called for
+// error responses, and with a nil response if no response was received at all.
+func WithResponseHook(hook func(*http.Response, time.Duration)) ClientOptionFunc {
+	return func(c *Client) error {
+		c.responseHook = hook
+		return nil
+	}
+}
+
 // WithResponseLogHook can be used to configure a custom response log hook.
 func WithResponseLogHook(hook retryablehttp.ResponseLogHook) ClientOptionFunc {
 	return func(c *Client) error {
@@ -133,6 +178,17 @@ func WithoutRetries() ClientOptionFunc {
 	}
 }
 
+// WithIdempotentRetries limits the retries of server (>= 500) errors to
+// idempotent requests. These are GET and HEAD requests, and requests with an
+// Idempotency-Key header. Other requests may already have been processed when
+// they fail, so they are only retried when they are rate limited (429).
+func WithIdempotentRetries() ClientOptionFunc {
+	return func(c *Client) error {
+		c.idempotentRetries = true
+		return nil
+	}
+}
+
 // WithRequestOptions can be used to configure default request options applied to every request.
 func WithRequestOptions(options ...RequestOptionFunc) ClientOptionFunc {
 	return func(c *Client) error {
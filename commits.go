@@ -82,6 +82,7 @@ type ListCommitsOptions struct {
 	All         *bool      `url:"all,omitempty" json:"all,omitempty"`
 	WithStats   *bool      `url:"with_stats,omitempty" json:"with_stats,omitempty"`
 	FirstParent *bool      `url:"first_parent,omitempty" json:"first_parent,omitempty"`
+	Order       *string    `url:"order,omitempty" json:"order,omitempty"`
 	Trailers    *bool      `url:"trailers,omitempty" json:"trailers,omitempty"`
 }
 
@@ -127,7 +128,9 @@ type GetCommitRefsOptions struct {
 	Type *string `url:"type,omitempty" json:"type,omitempty"`
 }
 
-// GetCommitRefs gets all references (from branches or tags) a commit is pushed to
+// GetCommitRefs gets all references (from branches or tags) a commit is pushed to.
+// Set the Type option to "branch" or "tag" to only get references of that type,
+// for example to find all tags containing a commit. The default is "all".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#get-references-a-commit-is-pushed-to
@@ -395,10 +398,11 @@ func (s *CommitsService) PostCommitComment(pid interface{}, sha string, opt *Pos
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-the-statuses-of-a-commit
 type GetCommitStatusesOptions struct {
 	ListOptions
-	Ref   *string `url:"ref,omitempty" json:"ref,omitempty"`
-	Stage *string `url:"stage,omitempty" json:"stage,omitempty"`
-	Name  *string `url:"name,omitempty" json:"name,omitempty"`
-	All   *bool   `url:"all,omitempty" json:"all,omitempty"`
+	Ref        *string `url:"ref,omitempty" json:"ref,omitempty"`
+	Stage      *string `url:"stage,omitempty" json:"stage,omitempty"`
+	Name       *string `url:"name,omitempty" json:"name,omitempty"`
+	PipelineID *int    `url:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
+	All        *bool   `url:"all,omitempty" json:"all,omitempty"`
 }
 
 // CommitStatus represents a GitLab commit status.
@@ -519,6 +523,10 @@ type CherryPickCommitOptions struct {
 
 // CherryPickCommit cherry picks a commit to a given branch.
 //
+// When DryRun is set no commit is created and the returned Commit is empty. If
+// the commit can not be cherry picked without conflicts, an *ErrorResponse
+// with status 400 is returned in both cases.
+//
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#cherry-pick-a-commit
 func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *CherryPickCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
 	project, err := parseID(pid)
@@ -546,10 +554,15 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 type RevertCommitOptions struct {
 	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
 // RevertCommit reverts a commit in a given branch.
 //
+// When DryRun is set no commit is created and the returned Commit is empty. If
+// the commit can not be reverted without conflicts, an *ErrorResponse with
+// status 400 is returned in both cases.
+//
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
 	project, err := parseID(pid)
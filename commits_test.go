@@ -79,14 +79,16 @@ func TestGetCommitStatuses(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/statuses", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "all=true&name=ci%2Fjenkins&pipeline_id=42&ref=master&stage=test")
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
 	opt := &GetCommitStatusesOptions{
-		Ref:   Ptr("master"),
-		Stage: Ptr("test"),
-		Name:  Ptr("ci/jenkins"),
-		All:   Ptr(true),
+		Ref:        Ptr("master"),
+		Stage:      Ptr("test"),
+		Name:       Ptr("ci/jenkins"),
+		PipelineID: Ptr(42),
+		All:        Ptr(true),
 	}
 	statuses, _, err := client.Commits.GetCommitStatuses("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
 	if err != nil {
@@ -99,6 +101,23 @@ func TestGetCommitStatuses(t *testing.T) {
 	}
 }
 
+func TestGetCommitStatusesByPipeline(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "pipeline_id=7")
+		fmt.Fprint(w, `[{"id":1,"name":"build","pipeline_id":7},{"id":2,"name":"test","pipeline_id":7}]`)
+	})
+
+	opt := &GetCommitStatusesOptions{PipelineID: Ptr(7)}
+	statuses, _, err := client.Commits.GetCommitStatuses(1, "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+	require.NoError(t, err)
+
+	want := []*CommitStatus{{ID: 1, Name: "build", PipelineId: 7}, {ID: 2, Name: "test", PipelineId: 7}}
+	require.Equal(t, want, statuses)
+}
+
 func TestSetCommitStatus(t *testing.T) {
 	mux, client := setup(t)
 
@@ -336,6 +355,31 @@ func TestCommitsService_ListCommits(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_ListCommitsWithOrder(t *testing.T) {
+	mux, client := setup(t)
+
+	var wantQuery string
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, wantQuery)
+		fmt.Fprint(w, `[{"id":"6104942438c14ec7bd21c6cd5bd995272b3faff6"}]`)
+	})
+
+	wantQuery = "first_parent=true&order=topo&ref_name=main"
+	cs, _, err := client.Commits.ListCommits(1, &ListCommitsOptions{
+		RefName:     Ptr("main"),
+		FirstParent: Ptr(true),
+		Order:       Ptr("topo"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*Commit{{ID: "6104942438c14ec7bd21c6cd5bd995272b3faff6"}}, cs)
+
+	// Existing callers not setting the new options are unaffected.
+	wantQuery = "ref_name=main"
+	_, _, err = client.Commits.ListCommits(1, &ListCommitsOptions{RefName: Ptr("main")})
+	require.NoError(t, err)
+}
+
 func TestCommitsService_GetCommitRefs(t *testing.T) {
 	mux, client := setup(t)
 
@@ -391,6 +435,30 @@ func TestCommitsService_GetCommitRefs(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_GetCommitRefsType(t *testing.T) {
+	refs := map[string][]*CommitRef{
+		"branch": {{Type: "branch", Name: "main"}},
+		"tag":    {{Type: "tag", Name: "v1.1.0"}},
+		"all":    {{Type: "branch", Name: "main"}, {Type: "tag", Name: "v1.1.0"}},
+	}
+
+	for refType, want := range refs {
+		t.Run(refType, func(t *testing.T) {
+			mux, client := setup(t)
+
+			mux.HandleFunc("/api/v4/projects/1/repository/commits/5937ac0a/refs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodGet)
+				testParams(t, r, "type="+refType)
+				require.NoError(t, json.NewEncoder(w).Encode(want))
+			})
+
+			crs, _, err := client.Commits.GetCommitRefs(1, "5937ac0a", &GetCommitRefsOptions{Type: Ptr(refType)})
+			require.NoError(t, err)
+			require.Equal(t, want, crs)
+		})
+	}
+}
+
 func TestCommitsService_CreateCommit(t *testing.T) {
 	mux, client := setup(t)
 
@@ -929,3 +997,80 @@ func TestCommitsService_CherryPickCommit(t *testing.T) {
 	require.Nil(t, c)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestCommitsService_CherryPickCommitDryRun(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"release","dry_run":true,"message":"Backport fix"}`)
+		fmt.Fprint(w, `{"dry_run":"success"}`)
+	})
+
+	c, _, err := client.Commits.CherryPickCommit(1, "master", &CherryPickCommitOptions{
+		Branch:  Ptr("release"),
+		DryRun:  Ptr(true),
+		Message: Ptr("Backport fix"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Commit{}, c)
+}
+
+func TestCommitsService_CherryPickCommitDryRunConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Sorry, we cannot cherry-pick this commit automatically. This commit may already have been cherry-picked, or a more recent commit may have updated some of its content.","error_code":"conflict"}`)
+	})
+
+	c, resp, err := client.Commits.CherryPickCommit(1, "master", &CherryPickCommitOptions{
+		Branch: Ptr("release"),
+		DryRun: Ptr(true),
+	})
+	require.Nil(t, c)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	require.Contains(t, errResp.Message, "cannot cherry-pick this commit automatically")
+	require.Contains(t, string(errResp.Body), `"error_code":"conflict"`)
+}
+
+func TestRevertCommit_DryRun(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/revert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"release","dry_run":true}`)
+		fmt.Fprint(w, `{"dry_run":"success"}`)
+	})
+
+	commit, _, err := client.Commits.RevertCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", &RevertCommitOptions{
+		Branch: Ptr("release"),
+		DryRun: Ptr(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Commit{}, commit)
+}
+
+func TestRevertCommit_DryRunConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/revert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Sorry, we cannot revert this commit automatically. This commit may already have been reverted, or a more recent commit may have updated some of its content.","error_code":"conflict"}`)
+	})
+
+	commit, resp, err := client.Commits.RevertCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", &RevertCommitOptions{
+		DryRun: Ptr(true),
+	})
+	require.Nil(t, commit)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	require.Contains(t, errResp.Message, "cannot revert this commit automatically")
+}
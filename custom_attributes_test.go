@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -240,3 +241,64 @@ func TestDeleteCustomProjectAttribute(t *testing.T) {
 		t.Errorf("CustomAttribute.DeleteCustomProjectAttribute returned %d, want %d", got, want)
 	}
 }
+
+func TestSetAndDeleteCustomProjectAttribute(t *testing.T) {
+	mux, client := setup(t)
+
+	attributes := map[string]string{}
+	mux.HandleFunc("/api/v4/projects/2/custom_attributes/cost.center", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/api/v4/projects/2/custom_attributes/cost%2Ecenter")
+
+		switch r.Method {
+		case http.MethodPut:
+			testBody(t, r, `{"key":"cost.center","value":"QA-42"}`)
+			attributes["cost.center"] = "QA-42"
+			fmt.Fprint(w, `{"key":"cost.center","value":"QA-42"}`)
+		case http.MethodGet:
+			value, ok := attributes["cost.center"]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"key":"cost.center","value":%q}`, value)
+		case http.MethodDelete:
+			delete(attributes, "cost.center")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method: %s", r.Method)
+		}
+	})
+
+	customAttribute, _, err := client.CustomAttribute.SetCustomProjectAttribute(2, CustomAttribute{
+		Key:   "cost.center",
+		Value: "QA-42",
+	})
+	if err != nil {
+		t.Fatalf("CustomAttribute.SetCustomProjectAttribute returned error: %v", err)
+	}
+
+	want := &CustomAttribute{Key: "cost.center", Value: "QA-42"}
+	if !reflect.DeepEqual(want, customAttribute) {
+		t.Errorf("CustomAttribute.SetCustomProjectAttribute returned %+v, want %+v", customAttribute, want)
+	}
+
+	customAttribute, _, err = client.CustomAttribute.GetCustomProjectAttribute(2, "cost.center")
+	if err != nil {
+		t.Fatalf("CustomAttribute.GetCustomProjectAttribute returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, customAttribute) {
+		t.Errorf("CustomAttribute.GetCustomProjectAttribute returned %+v, want %+v", customAttribute, want)
+	}
+
+	if _, err := client.CustomAttribute.DeleteCustomProjectAttribute(2, "cost.center"); err != nil {
+		t.Fatalf("CustomAttribute.DeleteCustomProjectAttribute returned error: %v", err)
+	}
+
+	_, resp, err := client.CustomAttribute.GetCustomProjectAttribute(2, "cost.center")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("CustomAttribute.GetCustomProjectAttribute returned error %v, want %v", err, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("CustomAttribute.GetCustomProjectAttribute returned status %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
@@ -392,3 +392,59 @@ func TestUpdateDeployKey(t *testing.T) {
 		t.Errorf("DeployKeys.UpdateDeployKey returned %+v, want %+v", deployKey, want)
 	}
 }
+
+func TestUpdateDeployKeyDisableCanPush(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/deploy_keys/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"can_push":false}`)
+		fmt.Fprintf(w, `{
+			"id": 11,
+			"title": "New deploy key",
+			"key": "ssh-rsa AAAA...",
+			"can_push": false
+		 }`)
+	})
+
+	deployKey, _, err := client.DeployKeys.UpdateDeployKey(5, 11, &UpdateDeployKeyOptions{CanPush: Ptr(false)})
+	if err != nil {
+		t.Errorf("DeployKeys.UpdateDeployKey returned error: %v", err)
+	}
+
+	want := &ProjectDeployKey{
+		ID:      11,
+		Title:   "New deploy key",
+		Key:     "ssh-rsa AAAA...",
+		CanPush: false,
+	}
+	if !reflect.DeepEqual(want, deployKey) {
+		t.Errorf("DeployKeys.UpdateDeployKey returned %+v, want %+v", deployKey, want)
+	}
+}
+
+func TestListProjectDeployKeysCanPush(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/group/project/deploy_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/group%2Fproject/deploy_keys")
+		fmt.Fprintf(w, `[
+			{"id": 1, "title": "Read only", "key": "ssh-rsa AAAA...", "can_push": false},
+			{"id": 2, "title": "Read write", "key": "ssh-rsa BBBB...", "can_push": true}
+		]`)
+	})
+
+	deployKeys, _, err := client.DeployKeys.ListProjectDeployKeys("group/project", nil)
+	if err != nil {
+		t.Errorf("DeployKeys.ListProjectDeployKeys returned error: %v", err)
+	}
+
+	want := []*ProjectDeployKey{
+		{ID: 1, Title: "Read only", Key: "ssh-rsa AAAA...", CanPush: false},
+		{ID: 2, Title: "Read write", Key: "ssh-rsa BBBB...", CanPush: true},
+	}
+	if !reflect.DeepEqual(want, deployKeys) {
+		t.Errorf("DeployKeys.ListProjectDeployKeys returned %+v, want %+v", deployKeys, want)
+	}
+}
@@ -30,7 +30,8 @@ type DeployTokensService struct {
 	client *Client
 }
 
-// DeployToken represents a GitLab deploy token.
+// DeployToken represents a GitLab deploy token. The Token is only returned
+// once, in the response of creating the deploy token.
 type DeployToken struct {
 	ID        int        `json:"id"`
 	Name      string     `json:"name"`
@@ -167,6 +167,7 @@ func TestCreateProjectDeployToken(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/5/deploy_tokens", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"My deploy token","expires_at":"2021-01-01T00:00:00Z","username":"custom-user","scopes":["read_repository"]}`)
 		fmt.Fprint(w, `
 {
 	"id": 1,
@@ -325,6 +326,7 @@ func TestCreateGroupDeployToken(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/groups/5/deploy_tokens", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"My deploy token","expires_at":"2021-01-01T00:00:00Z","username":"custom-user","scopes":["read_repository"]}`)
 		fmt.Fprint(w, `
 {
 	"id": 1,
@@ -208,6 +208,17 @@ func (s *DeploymentsService) UpdateProjectDeployment(pid interface{}, deployment
 	return d, resp, nil
 }
 
+// DeploymentApproval represents a GitLab deployment approval.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+type DeploymentApproval struct {
+	User      *BasicUser               `json:"user"`
+	Status    DeploymentApprovalStatus `json:"status"`
+	CreatedAt *time.Time               `json:"created_at"`
+	Comment   string                   `json:"comment"`
+}
+
 // ApproveOrRejectProjectDeploymentOptions represents the available
 // ApproveOrRejectProjectDeployment() options.
 //
@@ -220,24 +231,43 @@ type ApproveOrRejectProjectDeploymentOptions struct {
 }
 
 // ApproveOrRejectProjectDeployment approve or reject a blocked deployment.
+// Use SubmitProjectDeploymentApproval to also get the resulting approval.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
 func (s *DeploymentsService) ApproveOrRejectProjectDeployment(pid interface{}, deployment int,
 	opt *ApproveOrRejectProjectDeploymentOptions, options ...RequestOptionFunc,
 ) (*Response, error) {
+	_, resp, err := s.SubmitProjectDeploymentApproval(pid, deployment, opt, options...)
+	return resp, err
+}
+
+// SubmitProjectDeploymentApproval approves or rejects a blocked deployment
+// like ApproveOrRejectProjectDeployment, and returns the resulting approval.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+func (s *DeploymentsService) SubmitProjectDeploymentApproval(pid interface{}, deployment int,
+	opt *ApproveOrRejectProjectDeploymentOptions, options ...RequestOptionFunc,
+) (*DeploymentApproval, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/deployments/%d/approval", PathEscape(project), deployment)
 
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	da := new(DeploymentApproval)
+	resp, err := s.client.Do(req, da)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return da, resp, nil
 }
 
 // DeleteProjectDeployment delete a project deployment.
@@ -790,3 +790,130 @@ func TestDeploymentsService_UpdateProjectDeployment(t *testing.T) {
 	require.Nil(t, d)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestDeploymentsService_ApproveOrRejectProjectDeployment(t *testing.T) {
+	mux, client := setup(t)
+
+	tests := []struct {
+		name       string
+		deployment int
+		opt        *ApproveOrRejectProjectDeploymentOptions
+		wantBody   string
+		want       DeploymentApprovalStatus
+		comment    string
+	}{
+		{
+			name:       "approve",
+			deployment: 42,
+			opt: &ApproveOrRejectProjectDeploymentOptions{
+				Status:        Ptr(DeploymentApprovalStatusApproved),
+				Comment:       Ptr("Looks good to me"),
+				RepresentedAs: Ptr("security"),
+			},
+			wantBody: `{"status":"approved","comment":"Looks good to me","represented_as":"security"}`,
+			want:     DeploymentApprovalStatusApproved,
+			comment:  "Looks good to me",
+		},
+		{
+			name:       "reject",
+			deployment: 43,
+			opt: &ApproveOrRejectProjectDeploymentOptions{
+				Status: Ptr(DeploymentApprovalStatusRejected),
+			},
+			wantBody: `{"status":"rejected"}`,
+			want:     DeploymentApprovalStatusRejected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/deployments/%d/approval", tt.deployment), func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPost)
+				testBody(t, r, tt.wantBody)
+				fmt.Fprintf(w, `{
+					"user": {
+						"id": 100,
+						"username": "security-user-1",
+						"name": "security user-1",
+						"state": "active",
+						"avatar_url": "https://www.gravatar.com/avatar/e130fcd3a1681f41a3de69d10841afa9?s=80&d=identicon",
+						"web_url": "http://localhost:3000/security-user-1"
+					},
+					"status": %q,
+					"created_at": "2022-02-24T20:22:30.097Z",
+					"comment": %q
+				}`, tt.want, tt.comment)
+			})
+
+			resp, err := client.Deployments.ApproveOrRejectProjectDeployment(1, tt.deployment, tt.opt)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			da, resp, err := client.Deployments.SubmitProjectDeploymentApproval(1, tt.deployment, tt.opt)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			createdAt := time.Date(2022, 2, 24, 20, 22, 30, 97000000, time.UTC)
+			want := &DeploymentApproval{
+				User: &BasicUser{
+					ID:        100,
+					Username:  "security-user-1",
+					Name:      "security user-1",
+					State:     "active",
+					AvatarURL: "https://www.gravatar.com/avatar/e130fcd3a1681f41a3de69d10841afa9?s=80&d=identicon",
+					WebURL:    "http://localhost:3000/security-user-1",
+				},
+				Status:    tt.want,
+				CreatedAt: &createdAt,
+				Comment:   tt.comment,
+			}
+			require.Equal(t, want, da)
+		})
+	}
+}
+
+func TestDeploymentsService_ListProjectDeploymentsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "environment=production"+
+			"&finished_after=2024-01-01T00%3A00%3A00Z"+
+			"&finished_before=2024-02-01T00%3A00%3A00Z"+
+			"&order_by=finished_at&sort=desc&status=success")
+		fmt.Fprint(w, `[{"id":42,"status":"success"}]`)
+	})
+
+	finishedAfter := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	finishedBefore := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListProjectDeploymentsOptions{
+		OrderBy:        Ptr("finished_at"),
+		Sort:           Ptr("desc"),
+		Environment:    Ptr("production"),
+		Status:         Ptr("success"),
+		FinishedAfter:  &finishedAfter,
+		FinishedBefore: &finishedBefore,
+	}
+
+	deployments, _, err := client.Deployments.ListProjectDeployments(1, opt)
+	require.NoError(t, err)
+	require.Equal(t, []*Deployment{{ID: 42, Status: "success"}}, deployments)
+}
+
+func TestDeploymentsService_ListProjectDeploymentsUpdatedFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "updated_after=2024-01-01T00%3A00%3A00Z&updated_before=2024-02-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[]`)
+	})
+
+	updatedAfter := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	updatedBefore := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err := client.Deployments.ListProjectDeployments(1, &ListProjectDeploymentsOptions{
+		UpdatedAfter:  &updatedAfter,
+		UpdatedBefore: &updatedBefore,
+	})
+	require.NoError(t, err)
+}
@@ -2004,6 +2004,44 @@ func TestDiscussionsService_ResolveMergeRequestDiscussion(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestDiscussionsService_ResolveAndUnresolveMergeRequestDiscussion(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolved bool
+		wantBody string
+	}{
+		{name: "resolve", resolved: true, wantBody: `{"resolved":true}`},
+		{name: "unresolve", resolved: false, wantBody: `{"resolved":false}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, client := setup(t)
+
+			mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPut)
+				testBody(t, r, tt.wantBody)
+				fmt.Fprintf(w, `{
+					"id": "6a9c1750b37d513a43987b574953fceb50b03ce7",
+					"notes": [
+						{"id": 1126, "resolvable": true, "resolved": %[1]t},
+						{"id": 1127, "resolvable": true, "resolved": %[1]t}
+					]
+				}`, tt.resolved)
+			})
+
+			opt := &ResolveMergeRequestDiscussionOptions{Resolved: Ptr(tt.resolved)}
+			d, _, err := client.Discussions.ResolveMergeRequestDiscussion(5, 11, "6a9c1750b37d513a43987b574953fceb50b03ce7", opt)
+			require.NoError(t, err)
+
+			require.Len(t, d.Notes, 2)
+			for _, note := range d.Notes {
+				require.Equal(t, tt.resolved, note.Resolved)
+			}
+		})
+	}
+}
+
 func TestDiscussionsService_AddMergeRequestDiscussionNote(t *testing.T) {
 	mux, client := setup(t)
 
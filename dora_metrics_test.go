@@ -116,3 +116,60 @@ func TestDORAMetrics_GetGroupDORAMetrics(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, want, d)
 }
+
+func TestDORAMetrics_GetProjectDORAMetricsPerMetricType(t *testing.T) {
+	mux, client := setup(t)
+
+	tests := []struct {
+		metric DORAMetricType
+		body   string
+		want   []DORAMetric
+	}{
+		{
+			metric: DORAMetricDeploymentFrequency,
+			body:   `[{"date":"2021-03-01","value":3},{"date":"2021-04-01","value":5}]`,
+			want:   []DORAMetric{{Date: "2021-03-01", Value: 3}, {Date: "2021-04-01", Value: 5}},
+		},
+		{
+			metric: DORAMetricLeadTimeForChanges,
+			body:   `[{"date":"2021-03-01","value":86400.5}]`,
+			want:   []DORAMetric{{Date: "2021-03-01", Value: 86400.5}},
+		},
+		{
+			metric: DORAMetricTimeToRestoreService,
+			body:   `[{"date":"2021-03-01","value":3600}]`,
+			want:   []DORAMetric{{Date: "2021-03-01", Value: 3600}},
+		},
+		{
+			metric: DORAMetricChangeFailureRate,
+			body:   `[{"date":"2021-03-01","value":0.25}]`,
+			want:   []DORAMetric{{Date: "2021-03-01", Value: 0.25}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.metric), func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/api/v4/projects/%s/dora/metrics", tt.metric), func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodGet)
+				testParams(t, r, fmt.Sprintf(
+					"end_date=2021-04-30&environment_tiers=production%%2Cstaging&interval=monthly&metric=%s&start_date=2021-03-01",
+					tt.metric,
+				))
+				fmt.Fprint(w, tt.body)
+			})
+
+			startDate := ISOTime(time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC))
+			endDate := ISOTime(time.Date(2021, time.April, 30, 0, 0, 0, 0, time.UTC))
+
+			d, _, err := client.DORAMetrics.GetProjectDORAMetrics(string(tt.metric), GetDORAMetricsOptions{
+				Metric:           Ptr(tt.metric),
+				Interval:         Ptr(DORAMetricIntervalMonthly),
+				StartDate:        &startDate,
+				EndDate:          &endDate,
+				EnvironmentTiers: &[]string{"production", "staging"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, d)
+		})
+	}
+}
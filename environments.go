@@ -44,6 +44,7 @@ type Environment struct {
 	Project             *Project    `json:"project"`
 	CreatedAt           *time.Time  `json:"created_at"`
 	UpdatedAt           *time.Time  `json:"updated_at"`
+	AutoStopAt          *time.Time  `json:"auto_stop_at"`
 	LastDeployment      *Deployment `json:"last_deployment"`
 	ClusterAgent        *Agent      `json:"cluster_agent"`
 	KubernetesNamespace string      `json:"kubernetes_namespace"`
@@ -248,3 +249,32 @@ func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int
 
 	return env, resp, nil
 }
+
+// StopStaleEnvironmentsOptions represents the available
+// StopStaleEnvironments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-multiple-environments
+type StopStaleEnvironmentsOptions struct {
+	Before *ISOTime `url:"before,omitempty" json:"before,omitempty"`
+}
+
+// StopStaleEnvironments stops all environments of a specific project that
+// were last modified or deployed to before the given date.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-multiple-environments
+func (s *EnvironmentsService) StopStaleEnvironments(pid interface{}, opt *StopStaleEnvironmentsOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/stop_stale", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -175,6 +175,68 @@ func TestGetEnvironment(t *testing.T) {
 	}
 }
 
+func TestGetEnvironmentWithLastDeployment(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "production",
+			"slug": "production",
+			"external_url": "https://example.com",
+			"state": "available",
+			"tier": "production",
+			"auto_stop_at": "2024-02-01T10:00:00Z",
+			"last_deployment": {
+				"id": 100,
+				"iid": 34,
+				"ref": "main",
+				"sha": "b83d6e391c22777fca1ed3012fce84f633d7fed0",
+				"status": "success",
+				"created_at": "2024-01-01T10:00:00Z",
+				"user": {"id": 1, "name": "Administrator", "username": "root"},
+				"deployable": {
+					"id": 71,
+					"status": "success",
+					"stage": "deploy",
+					"name": "deploy",
+					"ref": "main",
+					"pipeline": {"id": 34, "sha": "b83d6e391c22777fca1ed3012fce84f633d7fed0", "ref": "main", "status": "success"}
+				}
+			}
+		}`)
+	})
+
+	env, _, err := client.Environments.GetEnvironment(1, 1)
+	if err != nil {
+		t.Fatalf("Environments.GetEnvironment returned error: %v", err)
+	}
+
+	autoStopAt := time.Date(2024, time.February, 1, 10, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "available", env.State)
+	assert.Equal(t, "production", env.Tier)
+	assert.Equal(t, "https://example.com", env.ExternalURL)
+	assert.Equal(t, &autoStopAt, env.AutoStopAt)
+
+	deployment := env.LastDeployment
+	if deployment == nil {
+		t.Fatal("Environments.GetEnvironment returned no last deployment")
+	}
+	assert.Equal(t, 100, deployment.ID)
+	assert.Equal(t, 34, deployment.IID)
+	assert.Equal(t, "main", deployment.Ref)
+	assert.Equal(t, "success", deployment.Status)
+	assert.Equal(t, &createdAt, deployment.CreatedAt)
+	assert.Equal(t, &ProjectUser{ID: 1, Name: "Administrator", Username: "root"}, deployment.User)
+	assert.Equal(t, 71, deployment.Deployable.ID)
+	assert.Equal(t, "deploy", deployment.Deployable.Stage)
+	assert.Equal(t, 34, deployment.Deployable.Pipeline.ID)
+	assert.Equal(t, "success", deployment.Deployable.Pipeline.Status)
+}
+
 func TestCreateEnvironment(t *testing.T) {
 	mux, client := setup(t)
 
@@ -361,6 +423,29 @@ func TestStopEnvironment(t *testing.T) {
 	}
 }
 
+func TestStopStaleEnvironments(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/stop_stale", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"before":"2024-03-15"}`)
+		fmt.Fprint(w, `{"message":"Successfully requested stop for all stale environments"}`)
+	})
+
+	before, err := ParseISOTime("2024-03-15")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Environments.StopStaleEnvironments(1, &StopStaleEnvironmentsOptions{Before: &before})
+	if err != nil {
+		t.Fatalf("Environments.StopStaleEnvironments returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Environments.StopStaleEnvironments returned status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
 func TestUnmarshal(t *testing.T) {
 	jsonObject := `
     {
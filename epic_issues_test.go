@@ -733,3 +733,47 @@ func TestEpicIssuesService_UpdateEpicIssueAssignment(t *testing.T) {
 	require.Nil(t, is)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestEpicIssuesService_UpdateEpicIssueAssignment_ReorderPayload(t *testing.T) {
+	mux, client := setup(t)
+
+	tests := []struct {
+		name string
+		opt  *UpdateEpicIsssueAssignmentOptions
+		want string
+	}{
+		{
+			name: "move before",
+			opt:  &UpdateEpicIsssueAssignmentOptions{MoveBeforeID: Ptr(3)},
+			want: `{"move_before_id":3}`,
+		},
+		{
+			name: "move after",
+			opt:  &UpdateEpicIsssueAssignmentOptions{MoveAfterID: Ptr(4)},
+			want: `{"move_after_id":4}`,
+		},
+		{
+			name: "move between",
+			opt:  &UpdateEpicIsssueAssignmentOptions{MoveBeforeID: Ptr(3), MoveAfterID: Ptr(4)},
+			want: `{"move_before_id":3,"move_after_id":4}`,
+		},
+	}
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/groups/1/epics/5/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `[{"id":76,"iid":6,"epic_issue_id":2},{"id":77,"iid":7,"epic_issue_id":3}]`)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantBody = tt.want
+
+			is, _, err := client.EpicIssues.UpdateEpicIssueAssignment(1, 5, 2, tt.opt)
+			require.NoError(t, err)
+			require.Len(t, is, 2)
+			require.Equal(t, 2, is[0].EpicIssueID)
+		})
+	}
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -44,44 +45,83 @@ type EpicAuthor struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/epics.html
 type Epic struct {
-	ID                      int         `json:"id"`
-	IID                     int         `json:"iid"`
-	GroupID                 int         `json:"group_id"`
-	ParentID                int         `json:"parent_id"`
-	Title                   string      `json:"title"`
-	Description             string      `json:"description"`
-	State                   string      `json:"state"`
-	Confidential            bool        `json:"confidential"`
-	WebURL                  string      `json:"web_url"`
-	Author                  *EpicAuthor `json:"author"`
-	StartDate               *ISOTime    `json:"start_date"`
-	StartDateIsFixed        bool        `json:"start_date_is_fixed"`
-	StartDateFixed          *ISOTime    `json:"start_date_fixed"`
-	StartDateFromMilestones *ISOTime    `json:"start_date_from_milestones"`
-	DueDate                 *ISOTime    `json:"due_date"`
-	DueDateIsFixed          bool        `json:"due_date_is_fixed"`
-	DueDateFixed            *ISOTime    `json:"due_date_fixed"`
-	DueDateFromMilestones   *ISOTime    `json:"due_date_from_milestones"`
-	CreatedAt               *time.Time  `json:"created_at"`
-	UpdatedAt               *time.Time  `json:"updated_at"`
-	ClosedAt                *time.Time  `json:"closed_at"`
-	Labels                  []string    `json:"labels"`
-	Upvotes                 int         `json:"upvotes"`
-	Downvotes               int         `json:"downvotes"`
-	UserNotesCount          int         `json:"user_notes_count"`
-	URL                     string      `json:"url"`
+	ID                      int             `json:"id"`
+	IID                     int             `json:"iid"`
+	GroupID                 int             `json:"group_id"`
+	ParentID                int             `json:"parent_id"`
+	Title                   string          `json:"title"`
+	Description             string          `json:"description"`
+	State                   string          `json:"state"`
+	Confidential            bool            `json:"confidential"`
+	WebURL                  string          `json:"web_url"`
+	Author                  *EpicAuthor     `json:"author"`
+	StartDate               *ISOTime        `json:"start_date"`
+	StartDateIsFixed        bool            `json:"start_date_is_fixed"`
+	StartDateFixed          *ISOTime        `json:"start_date_fixed"`
+	StartDateFromMilestones *ISOTime        `json:"start_date_from_milestones"`
+	DueDate                 *ISOTime        `json:"due_date"`
+	DueDateIsFixed          bool            `json:"due_date_is_fixed"`
+	DueDateFixed            *ISOTime        `json:"due_date_fixed"`
+	DueDateFromMilestones   *ISOTime        `json:"due_date_from_milestones"`
+	CreatedAt               *time.Time      `json:"created_at"`
+	UpdatedAt               *time.Time      `json:"updated_at"`
+	ClosedAt                *time.Time      `json:"closed_at"`
+	Labels                  []string        `json:"labels"`
+	LabelDetails            []*LabelDetails `json:"label_details"`
+	Upvotes                 int             `json:"upvotes"`
+	Downvotes               int             `json:"downvotes"`
+	UserNotesCount          int             `json:"user_notes_count"`
+	URL                     string          `json:"url"`
 }
 
 func (e Epic) String() string {
 	return Stringify(e)
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface. When the epics are
+// requested with label details, the names of the labels are stored in Labels
+// and the details in LabelDetails.
+func (e *Epic) UnmarshalJSON(data []byte) error {
+	type alias Epic
+
+	raw := struct {
+		*alias
+		Labels json.RawMessage `json:"labels"`
+	}{
+		alias: (*alias)(e),
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.Labels = nil
+	if len(raw.Labels) == 0 || string(raw.Labels) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw.Labels, &e.Labels); err == nil {
+		return nil
+	}
+
+	// We got label details instead of label names.
+	if err := json.Unmarshal(raw.Labels, &e.LabelDetails); err != nil {
+		return err
+	}
+	e.Labels = make([]string, 0, len(e.LabelDetails))
+	for _, details := range e.LabelDetails {
+		e.Labels = append(e.Labels, details.Name)
+	}
+
+	return nil
+}
+
 // ListGroupEpicsOptions represents the available ListGroupEpics() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/epics.html#list-epics-for-a-group
 type ListGroupEpicsOptions struct {
 	ListOptions
 	AuthorID                *int          `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AuthorUsername          *string       `url:"author_username,omitempty" json:"author_username,omitempty"`
 	Labels                  *LabelOptions `url:"labels,comma,omitempty" json:"labels,omitempty"`
 	WithLabelDetails        *bool         `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	OrderBy                 *string       `url:"order_by,omitempty" json:"order_by,omitempty"`
@@ -17,11 +17,13 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGetEpic(t *testing.T) {
@@ -152,3 +154,68 @@ func TestUpdateEpic(t *testing.T) {
 		t.Errorf("Epics.UpdateEpic returned %+v, want %+v", epic, want)
 	}
 }
+
+func TestListGroupEpicsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/7/epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "author_username=jramsay"+
+			"&created_after=2024-01-01T00%3A00%3A00Z&created_before=2024-06-01T00%3A00%3A00Z"+
+			"&include_ancestor_groups=true&include_descendant_groups=false"+
+			"&labels=roadmap%2Cq3&my_reaction_emoji=thumbsup&state=opened"+
+			"&updated_after=2024-02-01T00%3A00%3A00Z&updated_before=2024-07-01T00%3A00%3A00Z"+
+			"&with_labels_details=true")
+		fmt.Fprint(w, `[{
+			"id": 8,
+			"title": "Incredible idea",
+			"labels": [
+				{"id": 1, "name": "roadmap", "color": "#428BCA", "description": "Roadmap items", "text_color": "#FFFFFF"},
+				{"id": 2, "name": "q3", "color": "#FF0000", "description": null, "text_color": "#FFFFFF"}
+			]
+		}]`)
+	})
+
+	epics, _, err := client.Epics.ListGroupEpics(7, &ListGroupEpicsOptions{
+		AuthorUsername:          Ptr("jramsay"),
+		Labels:                  &LabelOptions{"roadmap", "q3"},
+		WithLabelDetails:        Ptr(true),
+		State:                   Ptr("opened"),
+		CreatedAfter:            Ptr(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedBefore:           Ptr(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAfter:            Ptr(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedBefore:           Ptr(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)),
+		IncludeAncestorGroups:   Ptr(true),
+		IncludeDescendantGroups: Ptr(false),
+		MyReactionEmoji:         Ptr("thumbsup"),
+	})
+	if err != nil {
+		t.Fatalf("Epics.ListGroupEpics returned error: %v", err)
+	}
+
+	want := []*Epic{{
+		ID:     8,
+		Title:  "Incredible idea",
+		Labels: []string{"roadmap", "q3"},
+		LabelDetails: []*LabelDetails{
+			{ID: 1, Name: "roadmap", Color: "#428BCA", Description: "Roadmap items", TextColor: "#FFFFFF"},
+			{ID: 2, Name: "q3", Color: "#FF0000", TextColor: "#FFFFFF"},
+		},
+	}}
+
+	if !reflect.DeepEqual(want, epics) {
+		t.Errorf("Epics.ListGroupEpics returned %+v, want %+v", epics, want)
+	}
+}
+
+func TestEpicUnmarshalLabelNames(t *testing.T) {
+	var epic Epic
+	if err := json.Unmarshal([]byte(`{"id":8,"labels":["roadmap","q3"]}`), &epic); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := Epic{ID: 8, Labels: []string{"roadmap", "q3"}}
+	if !reflect.DeepEqual(want, epic) {
+		t.Errorf("json.Unmarshal returned %+v, want %+v", epic, want)
+	}
+}
@@ -17,8 +17,11 @@
 package gitlab
 
 import (
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -31,6 +34,7 @@ const (
 	EventConfidentialNote        EventType = "Confidential Note Hook"
 	EventTypeBuild               EventType = "Build Hook"
 	EventTypeDeployment          EventType = "Deployment Hook"
+	EventTypeEmoji               EventType = "Emoji Hook"
 	EventTypeFeatureFlag         EventType = "Feature Flag Hook"
 	EventTypeIssue               EventType = "Issue Hook"
 	EventTypeJob                 EventType = "Job Hook"
@@ -79,6 +83,21 @@ func HookEventToken(r *http.Request) string {
 	return r.Header.Get(eventTokenHeader)
 }
 
+// ErrInvalidWebhookToken is returned by ParseWebhookWithSecret when the token
+// of the request is missing or does not match the secret.
+var ErrInvalidWebhookToken = errors.New("invalid webhook token")
+
+// ValidateWebhookToken reports whether the X-Gitlab-Token header of the given
+// request matches the secret token configured for the webhook. The comparison
+// is done in constant time. A request without a token is never valid.
+func ValidateWebhookToken(r *http.Request, secret string) bool {
+	token := HookEventToken(r)
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
 const eventTypeHeader = "X-Gitlab-Event"
 
 // HookEventType returns the event type for the given request.
@@ -196,6 +215,38 @@ func WebhookEventType(r *http.Request) EventType {
 	return EventType(r.Header.Get(eventTypeHeader))
 }
 
+// ParseWebhookWithSecret validates the token of the request against the given
+// secret and parses the event payload read from its body. When the token is
+// invalid, ErrInvalidWebhookToken is returned without reading the body.
+//
+// Example usage:
+//
+//	func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    event, err := gitlab.ParseWebhookWithSecret(r, s.secret)
+//	    if errors.Is(err, gitlab.ErrInvalidWebhookToken) {
+//	        http.Error(w, err.Error(), http.StatusUnauthorized)
+//	        return
+//	    }
+//	    if err != nil { ... }
+//	    switch event := event.(type) {
+//	    case *gitlab.PushEvent:
+//	        processPushEvent(event)
+//	    ...
+//	    }
+//	}
+func ParseWebhookWithSecret(r *http.Request, secret string) (event interface{}, err error) {
+	if !ValidateWebhookToken(r, secret) {
+		return nil, ErrInvalidWebhookToken
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseWebhook(HookEventType(r), payload)
+}
+
 // ParseWebhook parses the event payload. For recognized event types, a
 // value of the corresponding struct type will be returned. An error will
 // be returned for unrecognized event types.
@@ -221,6 +272,8 @@ func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err e
 		event = &BuildEvent{}
 	case EventTypeDeployment:
 		event = &DeploymentEvent{}
+	case EventTypeEmoji:
+		event = &EmojiEvent{}
 	case EventTypeFeatureFlag:
 		event = &FeatureFlagEvent{}
 	case EventTypeIssue, EventConfidentialIssue:
@@ -17,11 +17,13 @@
 package gitlab
 
 import (
+	"bytes"
 	"net/http"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWebhookEventType(t *testing.T) {
@@ -50,6 +52,68 @@ func TestWebhookEventToken(t *testing.T) {
 	}
 }
 
+func TestValidateWebhookToken(t *testing.T) {
+	const secret = "798d3dd3-67f5-41df-ad19-7882cc6263bf"
+
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "valid token", token: secret, want: true},
+		{name: "invalid token", token: "798d3dd3-67f5-41df-ad19-000000000000", want: false},
+		{name: "shorter token", token: "798d3dd3", want: false},
+		{name: "missing token", token: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", nil)
+			require.NoError(t, err)
+			if tt.token != "" {
+				req.Header.Set("X-Gitlab-Token", tt.token)
+			}
+
+			assert.Equal(t, tt.want, ValidateWebhookToken(req, secret))
+		})
+	}
+}
+
+func TestParseWebhookWithSecret(t *testing.T) {
+	const secret = "798d3dd3-67f5-41df-ad19-7882cc6263bf"
+
+	newRequest := func(t *testing.T, token string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+		require.NoError(t, err)
+		req.Header.Set("X-Gitlab-Event", "Push Hook")
+		if token != "" {
+			req.Header.Set("X-Gitlab-Token", token)
+		}
+		return req
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		event, err := ParseWebhookWithSecret(newRequest(t, secret), secret)
+		require.NoError(t, err)
+
+		pushEvent, ok := event.(*PushEvent)
+		require.True(t, ok, "Expected PushEvent, but parsing produced %T", event)
+		assert.Equal(t, eventObjectKindPush, pushEvent.ObjectKind)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		event, err := ParseWebhookWithSecret(newRequest(t, "wrong"), secret)
+		assert.ErrorIs(t, err, ErrInvalidWebhookToken)
+		assert.Nil(t, event)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		event, err := ParseWebhookWithSecret(newRequest(t, ""), secret)
+		assert.ErrorIs(t, err, ErrInvalidWebhookToken)
+		assert.Nil(t, event)
+	})
+}
+
 func TestParseBuildHook(t *testing.T) {
 	raw := loadFixture("testdata/webhooks/build.json")
 
@@ -114,6 +178,62 @@ func TestParseCommitCommentHook(t *testing.T) {
 	}
 }
 
+func TestParseDeploymentHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/deployment.json")
+
+	parsedEvent, err := ParseWebhook("Deployment Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing deployment hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*DeploymentEvent)
+	if !ok {
+		t.Errorf("Expected DeploymentEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.ObjectKind != "deployment" {
+		t.Errorf("ObjectKind is %s, want %s", event.ObjectKind, "deployment")
+	}
+
+	if event.Status != "success" {
+		t.Errorf("Status is %s, want %s", event.Status, "success")
+	}
+
+	if event.DeployableID != 796 {
+		t.Errorf("DeployableID is %d, want %d", event.DeployableID, 796)
+	}
+
+	if event.Environment != "staging" {
+		t.Errorf("Environment is %s, want %s", event.Environment, "staging")
+	}
+
+	if event.Project.PathWithNamespace != "root/test-deployment-webhooks" {
+		t.Errorf("Project.PathWithNamespace is %s, want %s", event.Project.PathWithNamespace, "root/test-deployment-webhooks")
+	}
+}
+
+func TestParseEmojiHook(t *testing.T) {
+	raw := loadFixture("testdata/webhooks/emoji.json")
+
+	parsedEvent, err := ParseWebhook("Emoji Hook", raw)
+	if err != nil {
+		t.Errorf("Error parsing emoji hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*EmojiEvent)
+	if !ok {
+		t.Errorf("Expected EmojiEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.ObjectKind != "emoji" {
+		t.Errorf("ObjectKind is %s, want %s", event.ObjectKind, "emoji")
+	}
+
+	if event.ObjectAttributes.Name != "thumbsup" {
+		t.Errorf("ObjectAttributes.Name is %s, want %s", event.ObjectAttributes.Name, "thumbsup")
+	}
+}
+
 func TestParseFeatureFlagHook(t *testing.T) {
 	raw := loadFixture("testdata/webhooks/feature_flag.json")
 
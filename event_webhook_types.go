@@ -145,6 +145,7 @@ type DeploymentEvent struct {
 	DeployableURL          string `json:"deployable_url"`
 	Environment            string `json:"environment"`
 	EnvironmentSlug        string `json:"environment_slug"`
+	EnvironmentTier        string `json:"environment_tier"`
 	EnvironmentExternalURL string `json:"environment_external_url"`
 	Project                struct {
 		ID                int     `json:"id"`
@@ -172,6 +173,95 @@ type DeploymentEvent struct {
 	CommitTitle string     `json:"commit_title"`
 }
 
+// EmojiEvent represents an emoji event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#emoji-events
+type EmojiEvent struct {
+	ObjectKind string     `json:"object_kind"`
+	EventType  string     `json:"event_type"`
+	User       *EventUser `json:"user"`
+	ProjectID  int        `json:"project_id"`
+	Project    struct {
+		ID                int             `json:"id"`
+		Name              string          `json:"name"`
+		Description       string          `json:"description"`
+		WebURL            string          `json:"web_url"`
+		AvatarURL         string          `json:"avatar_url"`
+		GitSSHURL         string          `json:"git_ssh_url"`
+		GitHTTPURL        string          `json:"git_http_url"`
+		Namespace         string          `json:"namespace"`
+		VisibilityLevel   int             `json:"visibility_level"`
+		PathWithNamespace string          `json:"path_with_namespace"`
+		DefaultBranch     string          `json:"default_branch"`
+		CIConfigPath      string          `json:"ci_config_path"`
+		Homepage          string          `json:"homepage"`
+		URL               string          `json:"url"`
+		SSHURL            string          `json:"ssh_url"`
+		HTTPURL           string          `json:"http_url"`
+		Visibility        VisibilityValue `json:"visibility"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		ID            int    `json:"id"`
+		UserID        int    `json:"user_id"`
+		Name          string `json:"name"`
+		AwardableType string `json:"awardable_type"`
+		AwardableID   int    `json:"awardable_id"`
+		CreatedAt     string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+		UpdatedAt     string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
+		AwardedOnURL  string `json:"awarded_on_url"`
+	} `json:"object_attributes"`
+	Note *struct {
+		ID           int    `json:"id"`
+		Note         string `json:"note"`
+		NoteableType string `json:"noteable_type"`
+		NoteableID   int    `json:"noteable_id"`
+		AuthorID     int    `json:"author_id"`
+		ProjectID    int    `json:"project_id"`
+		CommitID     string `json:"commit_id"`
+		System       bool   `json:"system"`
+		URL          string `json:"url"`
+	} `json:"note"`
+	Issue *struct {
+		ID          int    `json:"id"`
+		IID         int    `json:"iid"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		AuthorID    int    `json:"author_id"`
+		ProjectID   int    `json:"project_id"`
+		State       string `json:"state"`
+		URL         string `json:"url"`
+	} `json:"issue"`
+	MergeRequest *struct {
+		ID           int    `json:"id"`
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		AuthorID     int    `json:"author_id"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		State        string `json:"state"`
+		URL          string `json:"url"`
+	} `json:"merge_request"`
+	Snippet *struct {
+		ID        int    `json:"id"`
+		Title     string `json:"title"`
+		Content   string `json:"content"`
+		AuthorID  int    `json:"author_id"`
+		ProjectID int    `json:"project_id"`
+		FileName  string `json:"file_name"`
+		URL       string `json:"url"`
+	} `json:"snippet"`
+	Commit *struct {
+		ID        string `json:"id"`
+		Title     string `json:"title"`
+		Message   string `json:"message"`
+		Timestamp string `json:"timestamp"`
+		URL       string `json:"url"`
+	} `json:"commit"`
+	Repository *Repository `json:"repository"`
+}
+
 // FeatureFlagEvent represents a feature flag event.
 //
 // GitLab API docs:
@@ -823,22 +913,22 @@ func (p *MergeParams) UnmarshalJSON(b []byte) error {
 type PipelineEvent struct {
 	ObjectKind       string `json:"object_kind"`
 	ObjectAttributes struct {
-		ID             int      `json:"id"`
-		IID            int      `json:"iid"`
-		Name           string   `json:"name"`
-		Ref            string   `json:"ref"`
-		Tag            bool     `json:"tag"`
-		SHA            string   `json:"sha"`
-		BeforeSHA      string   `json:"before_sha"`
-		Source         string   `json:"source"`
-		Status         string   `json:"status"`
-		DetailedStatus string   `json:"detailed_status"`
-		Stages         []string `json:"stages"`
-		CreatedAt      string   `json:"created_at"`
-		FinishedAt     string   `json:"finished_at"`
-		Duration       int      `json:"duration"`
-		QueuedDuration int      `json:"queued_duration"`
-		URL            string   `json:"url"`
+		ID             int                 `json:"id"`
+		IID            int                 `json:"iid"`
+		Name           string              `json:"name"`
+		Ref            string              `json:"ref"`
+		Tag            bool                `json:"tag"`
+		SHA            string              `json:"sha"`
+		BeforeSHA      string              `json:"before_sha"`
+		Source         string              `json:"source"`
+		Status         PipelineStatusValue `json:"status"`
+		DetailedStatus string              `json:"detailed_status"`
+		Stages         []string            `json:"stages"`
+		CreatedAt      string              `json:"created_at"`
+		FinishedAt     string              `json:"finished_at"`
+		Duration       int                 `json:"duration"`
+		QueuedDuration int                 `json:"queued_duration"`
+		URL            string              `json:"url"`
 		Variables      []struct {
 			Key   string `json:"key"`
 			Value string `json:"value"`
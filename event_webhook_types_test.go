@@ -239,11 +239,61 @@ func TestDeploymentEventUnmarshal(t *testing.T) {
 		t.Errorf("EnvironmentSlug is %s, want %s", event.EnvironmentSlug, "staging")
 	}
 
+	if event.EnvironmentTier != "staging" {
+		t.Errorf("EnvironmentTier is %s, want %s", event.EnvironmentTier, "staging")
+	}
+
 	if event.EnvironmentExternalURL != "https://staging.example.com" {
 		t.Errorf("EnvironmentExternalURL is %s, want %s", event.EnvironmentExternalURL, "https://staging.example.com")
 	}
 }
 
+func TestEmojiEventUnmarshal(t *testing.T) {
+	jsonObject := loadFixture("testdata/webhooks/emoji.json")
+
+	var event *EmojiEvent
+	err := json.Unmarshal(jsonObject, &event)
+	if err != nil {
+		t.Errorf("Emoji Event can not unmarshaled: %v\n ", err.Error())
+	}
+
+	if event == nil {
+		t.Errorf("Emoji Event is null")
+	}
+
+	if event.ObjectKind != "emoji" {
+		t.Errorf("ObjectKind is %s, want %s", event.ObjectKind, "emoji")
+	}
+
+	if event.EventType != "award" {
+		t.Errorf("EventType is %s, want %s", event.EventType, "award")
+	}
+
+	if event.ProjectID != 6 {
+		t.Errorf("ProjectID is %d, want %d", event.ProjectID, 6)
+	}
+
+	if event.User.Name != "Administrator" {
+		t.Errorf("Username is %s, want %s", event.User.Name, "Administrator")
+	}
+
+	if event.ObjectAttributes.AwardableType != "Issue" {
+		t.Errorf("ObjectAttributes.AwardableType is %s, want %s", event.ObjectAttributes.AwardableType, "Issue")
+	}
+
+	if event.ObjectAttributes.AwardableID != 97 {
+		t.Errorf("ObjectAttributes.AwardableID is %d, want %d", event.ObjectAttributes.AwardableID, 97)
+	}
+
+	if event.Issue == nil || event.Issue.IID != 42 {
+		t.Errorf("Issue is %+v, want IID %d", event.Issue, 42)
+	}
+
+	if event.MergeRequest != nil {
+		t.Errorf("MergeRequest is %+v, want nil", event.MergeRequest)
+	}
+}
+
 func TestFeatureFlagEventUnmarshal(t *testing.T) {
 	jsonObject := loadFixture("testdata/webhooks/feature_flag.json")
 
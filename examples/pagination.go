@@ -99,3 +99,32 @@ func keysetPagination() {
 		}
 	}
 }
+
+func paginationWithScan() {
+	git, err := gitlab.NewClient("yourtokengoeshere")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opt := &gitlab.ListProjectsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 10,
+		},
+	}
+
+	list := func(options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+		return git.Projects.ListProjects(opt, options...)
+	}
+
+	// Scan requests the next pages for us until we've seen all projects. With
+	// Go 1.23 or newer this can also be written as a range loop:
+	//
+	//	for p, err := range gitlab.Scan(list) {
+	gitlab.Scan(list)(func(p *gitlab.Project, err error) bool {
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Found project: %s", p.Name)
+		return true
+	})
+}
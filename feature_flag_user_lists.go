@@ -0,0 +1,200 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// FeatureFlagUserListsService handles communication with the feature flag
+// user lists related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html
+type FeatureFlagUserListsService struct {
+	client *Client
+}
+
+// FeatureFlagUserList represents a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html
+type FeatureFlagUserList struct {
+	ID        int        `json:"id"`
+	IID       int        `json:"iid"`
+	ProjectID int        `json:"project_id"`
+	Name      string     `json:"name"`
+	UserXIDs  string     `json:"user_xids"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (l FeatureFlagUserList) String() string {
+	return Stringify(l)
+}
+
+// ListFeatureFlagUserListsOptions represents the available
+// ListFeatureFlagUserLists() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#list-all-feature-flag-user-lists-for-a-project
+type ListFeatureFlagUserListsOptions struct {
+	ListOptions
+	Search *string `url:"search,omitempty" json:"search,omitempty"`
+}
+
+// ListFeatureFlagUserLists gets all feature flag user lists of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#list-all-feature-flag-user-lists-for-a-project
+func (s *FeatureFlagUserListsService) ListFeatureFlagUserLists(pid interface{}, opt *ListFeatureFlagUserListsOptions, options ...RequestOptionFunc) ([]*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lists []*FeatureFlagUserList
+	resp, err := s.client.Do(req, &lists)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return lists, resp, nil
+}
+
+// GetFeatureFlagUserList gets a single feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#get-a-feature-flag-user-list
+func (s *FeatureFlagUserListsService) GetFeatureFlagUserList(pid interface{}, iid int, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", PathEscape(project), iid)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, nil
+}
+
+// CreateFeatureFlagUserListOptions represents the available
+// CreateFeatureFlagUserList() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#create-a-feature-flag-user-list
+type CreateFeatureFlagUserListOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	UserXIDs *string `url:"user_xids,omitempty" json:"user_xids,omitempty"`
+}
+
+// CreateFeatureFlagUserList creates a feature flag user list. The user IDs
+// are passed as a comma-separated list of external user IDs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#create-a-feature-flag-user-list
+func (s *FeatureFlagUserListsService) CreateFeatureFlagUserList(pid interface{}, opt *CreateFeatureFlagUserListOptions, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, nil
+}
+
+// UpdateFeatureFlagUserListOptions represents the available
+// UpdateFeatureFlagUserList() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#update-a-feature-flag-user-list
+type UpdateFeatureFlagUserListOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	UserXIDs *string `url:"user_xids,omitempty" json:"user_xids,omitempty"`
+}
+
+// UpdateFeatureFlagUserList updates a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#update-a-feature-flag-user-list
+func (s *FeatureFlagUserListsService) UpdateFeatureFlagUserList(pid interface{}, iid int, opt *UpdateFeatureFlagUserListOptions, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", PathEscape(project), iid)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, nil
+}
+
+// DeleteFeatureFlagUserList deletes a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#delete-feature-flag-user-list
+func (s *FeatureFlagUserListsService) DeleteFeatureFlagUserList(pid interface{}, iid int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", PathEscape(project), iid)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
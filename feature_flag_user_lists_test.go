@@ -0,0 +1,126 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFeatureFlagUserLists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "search=beta")
+		fmt.Fprint(w, `[
+			{
+				"name": "beta_testers",
+				"user_xids": "user1,user2",
+				"id": 1,
+				"iid": 1,
+				"project_id": 1,
+				"created_at": "2020-02-04T08:13:51.000Z",
+				"updated_at": "2020-02-04T08:13:51.000Z"
+			}
+		]`)
+	})
+
+	lists, _, err := client.FeatureFlagUserLists.ListFeatureFlagUserLists(1, &ListFeatureFlagUserListsOptions{
+		Search: Ptr("beta"),
+	})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2020, 2, 4, 8, 13, 51, 0, time.UTC)
+	expected := []*FeatureFlagUserList{{
+		ID:        1,
+		IID:       1,
+		ProjectID: 1,
+		Name:      "beta_testers",
+		UserXIDs:  "user1,user2",
+		CreatedAt: &createdAt,
+		UpdatedAt: &createdAt,
+	}}
+	assert.Equal(t, expected, lists)
+}
+
+func TestGetFeatureFlagUserList(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"name": "beta_testers", "user_xids": "user1,user2", "id": 1, "iid": 1, "project_id": 1}`)
+	})
+
+	list, _, err := client.FeatureFlagUserLists.GetFeatureFlagUserList(1, 1)
+	require.NoError(t, err)
+
+	expected := &FeatureFlagUserList{ID: 1, IID: 1, ProjectID: 1, Name: "beta_testers", UserXIDs: "user1,user2"}
+	assert.Equal(t, expected, list)
+}
+
+func TestCreateFeatureFlagUserList(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"beta_testers","user_xids":"user1,user2"}`)
+		fmt.Fprint(w, `{"name": "beta_testers", "user_xids": "user1,user2", "id": 1, "iid": 1, "project_id": 1}`)
+	})
+
+	list, _, err := client.FeatureFlagUserLists.CreateFeatureFlagUserList(1, &CreateFeatureFlagUserListOptions{
+		Name:     Ptr("beta_testers"),
+		UserXIDs: Ptr("user1,user2"),
+	})
+	require.NoError(t, err)
+
+	expected := &FeatureFlagUserList{ID: 1, IID: 1, ProjectID: 1, Name: "beta_testers", UserXIDs: "user1,user2"}
+	assert.Equal(t, expected, list)
+}
+
+func TestUpdateFeatureFlagUserList(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"user_xids":"user1,user2,user3"}`)
+		fmt.Fprint(w, `{"name": "beta_testers", "user_xids": "user1,user2,user3", "id": 1, "iid": 1, "project_id": 1}`)
+	})
+
+	list, _, err := client.FeatureFlagUserLists.UpdateFeatureFlagUserList(1, 1, &UpdateFeatureFlagUserListOptions{
+		UserXIDs: Ptr("user1,user2,user3"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "user1,user2,user3", list.UserXIDs)
+}
+
+func TestDeleteFeatureFlagUserList(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.FeatureFlagUserLists.DeleteFeatureFlagUserList(1, 1)
+	require.NoError(t, err)
+}
@@ -156,3 +156,30 @@ func (s *GenericPackagesService) DownloadPackageFile(pid interface{}, packageNam
 
 	return f.Bytes(), resp, err
 }
+
+// StreamPackageFile streams the package file. Unlike DownloadPackageFile the
+// file is not buffered in memory. Instead the response body is returned as is
+// and the caller is responsible for closing it once done reading.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
+func (s *GenericPackagesService) StreamPackageFile(pid interface{}, packageName, packageVersion, fileName string, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/generic/%s/%s/%s",
+		PathEscape(project),
+		PathEscape(packageName),
+		PathEscape(packageVersion),
+		PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.doStream(req)
+}
@@ -17,7 +17,11 @@
 package gitlab
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
@@ -62,3 +66,35 @@ func TestDownloadPackageFile(t *testing.T) {
 		t.Errorf("GenericPackages.DownloadPackageFile returned %+v, want %+v", packageBytes, want)
 	}
 }
+
+func TestStreamPackageFile(t *testing.T) {
+	mux, client := setup(t)
+
+	content := bytes.Repeat([]byte("bar = baz\n"), 1024)
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write(content)
+	})
+
+	body, _, err := client.GenericPackages.StreamPackageFile(1234, "foo", "0.1.2", "bar-baz.txt")
+	if err != nil {
+		t.Fatalf("GenericPackages.StreamPackageFile returned error: %v", err)
+	}
+	defer body.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, body)
+	if err != nil {
+		t.Fatalf("GenericPackages.StreamPackageFile error reading: %v", err)
+	}
+
+	if n != int64(len(content)) {
+		t.Errorf("GenericPackages.StreamPackageFile returned %d bytes, want %d", n, len(content))
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != checksum {
+		t.Errorf("GenericPackages.StreamPackageFile returned checksum %s, want %s", got, checksum)
+	}
+}
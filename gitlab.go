@@ -48,10 +48,20 @@ const (
 	apiVersionPath = "api/v4/"
 	userAgent      = "go-gitlab"
 
-	headerRateLimit = "RateLimit-Limit"
-	headerRateReset = "RateLimit-Reset"
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+
+	defaultRetryMax     = 5
+	defaultRetryWaitMin = 100 * time.Millisecond
+	defaultRetryWaitMax = 400 * time.Millisecond
 )
 
+// authHeaders are the headers used to authenticate (or impersonate) a user,
+// which are removed when a request is redirected to another host.
+var authHeaders = []string{"Authorization", "JOB-TOKEN", "PRIVATE-TOKEN", "SUDO"}
+
 // AuthType represents an authentication type within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/
@@ -82,6 +92,13 @@ type Client struct {
 	// disableRetries is used to disable the default retry logic.
 	disableRetries bool
 
+	// idempotentRetries is used to only retry server errors of idempotent
+	// requests.
+	idempotentRetries bool
+
+	// transport is used to replace the transport of the HTTP client.
+	transport http.RoundTripper
+
 	// configureLimiterOnce is used to make sure the limiter is configured exactly
 	// once and block all other calls until the initial (one) call is done.
 	configureLimiterOnce sync.Once
@@ -89,6 +106,10 @@ type Client struct {
 	// Limiter is used to limit API calls and prevent 429 responses.
 	limiter RateLimiter
 
+	// The client a copy made by WithSudo was created from. Copies use the
+	// rate limiter of their parent, so they share its rate limit.
+	parent *Client
+
 	// Token type used to make authenticated API calls.
 	authType AuthType
 
@@ -101,9 +122,20 @@ type Client struct {
 	// Protects the token field from concurrent read/write accesses.
 	tokenLock sync.RWMutex
 
+	// Token source used to retrieve (and refresh) OAuth tokens.
+	tokenSource oauth2.TokenSource
+
 	// Default request options applied to every request.
 	defaultRequestOptions []RequestOptionFunc
 
+	// Default access level used when adding group or project members.
+	defaultAccessLevel *AccessLevelValue
+
+	// Hooks called once per API call, before sending the request and after
+	// receiving the (final) response.
+	requestHook  func(*http.Request)
+	responseHook func(*http.Response, time.Duration)
+
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
@@ -136,11 +168,13 @@ type Client struct {
 	ErrorTracking                *ErrorTrackingService
 	Events                       *EventsService
 	ExternalStatusChecks         *ExternalStatusChecksService
+	FeatureFlagUserLists         *FeatureFlagUserListsService
 	Features                     *FeaturesService
 	FreezePeriods                *FreezePeriodsService
 	GenericPackages              *GenericPackagesService
 	GeoNodes                     *GeoNodesService
 	GitIgnoreTemplates           *GitIgnoreTemplatesService
+	GraphQL                      *GraphQLClient
 	GroupAccessTokens            *GroupAccessTokensService
 	GroupBadges                  *GroupBadgesService
 	GroupCluster                 *GroupClustersService
@@ -249,6 +283,9 @@ type ListOptions struct {
 	OrderBy string `url:"order_by,omitempty" json:"order_by,omitempty"`
 	// For keyset-based paginated result sets, sort order (`"asc"`` or `"desc"`)
 	Sort string `url:"sort,omitempty" json:"sort,omitempty"`
+	// For keyset-based paginated result sets using a cursor, the cursor at
+	// which to fetch the next page.
+	Cursor string `url:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // RateLimiter describes the interface that all (custom) rate limiters must implement.
@@ -307,18 +344,33 @@ func NewOAuthClient(token string, options ...ClientOptionFunc) (*Client, error)
 	return client, nil
 }
 
+// NewOAuthClientWithTokenSource returns a new GitLab API client which uses
+// the given token source to retrieve an oauth token for every request. This
+// makes it possible to transparently refresh expired tokens, for example by
+// using the token source of an oauth2.Config. The token source must be safe
+// for concurrent use by multiple goroutines.
+func NewOAuthClientWithTokenSource(tokenSource oauth2.TokenSource, options ...ClientOptionFunc) (*Client, error) {
+	client, err := newClient(options...)
+	if err != nil {
+		return nil, err
+	}
+	client.authType = OAuthToken
+	client.tokenSource = tokenSource
+	return client, nil
+}
+
 func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c := &Client{UserAgent: userAgent}
 
 	// Configure the HTTP client.
 	c.client = &retryablehttp.Client{
-		Backoff:      c.retryHTTPBackoff,
+		Backoff:      retryHTTPBackoff,
 		CheckRetry:   c.retryHTTPCheck,
 		ErrorHandler: retryablehttp.PassthroughErrorHandler,
 		HTTPClient:   cleanhttp.DefaultPooledClient(),
-		RetryWaitMin: 100 * time.Millisecond,
-		RetryWaitMax: 400 * time.Millisecond,
-		RetryMax:     5,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+		RetryMax:     defaultRetryMax,
 	}
 
 	// Set the default base URL.
@@ -334,6 +386,48 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		}
 	}
 
+	// Wrap the configured retry policy, which may have been replaced using
+	// a client option, so retries can be disabled for single requests.
+	if checkRetry := c.client.CheckRetry; checkRetry != nil {
+		c.client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			retry, err := checkRetry(ctx, resp, err)
+			if ctx.Value(withoutRetryKey{}) != nil {
+				return false, err
+			}
+			return retry, err
+		}
+	}
+
+	// Use a copy of the configured HTTP client, so a client passed in using
+	// WithHTTPClient is not modified.
+	httpClient := *c.client.HTTPClient
+	c.client.HTTPClient = &httpClient
+
+	// If a custom transport was set using a client option, use it with the
+	// configured HTTP client.
+	if c.transport != nil {
+		httpClient.Transport = c.transport
+	}
+
+	// Redirects, for example of release asset downloads, may point to any
+	// host. Make sure the credentials of the client are only sent to the
+	// host of the original request.
+	checkRedirect := httpClient.CheckRedirect
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if req.URL.Host != via[0].URL.Host {
+			for _, header := range authHeaders {
+				req.Header.Del(header)
+			}
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+
 	// If no custom limiter was set using a client option, configure
 	// the default rate limiter with values that implicitly disable
 	// rate limiting until an initial HTTP call is done and we can
@@ -342,6 +436,58 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		c.limiter = rate.NewLimiter(rate.Inf, 0)
 	}
 
+	// Create all the services.
+	c.initServices()
+
+	return c, nil
+}
+
+// WithSudo returns a copy of the client which sends every request on behalf
+// of the given user, which can be either a user ID or a username. This is the
+// same as passing the WithSudo request option to every request, but makes it
+// possible to hand out a client per impersonated user in concurrent code.
+//
+// The copy shares the HTTP client, base URL, credentials and rate limiter with
+// the original client, which is not modified. Requests of all copies count
+// towards the same rate limit.
+func (c *Client) WithSudo(uid interface{}) *Client {
+	c.tokenLock.RLock()
+	token := c.token
+	c.tokenLock.RUnlock()
+
+	// Use a new slice, so the default request options of the original client
+	// and its copies never share a backing array.
+	defaultRequestOptions := make([]RequestOptionFunc, 0, len(c.defaultRequestOptions)+1)
+	defaultRequestOptions = append(defaultRequestOptions, c.defaultRequestOptions...)
+	defaultRequestOptions = append(defaultRequestOptions, WithSudo(uid))
+
+	clone := &Client{
+		client:                c.client,
+		baseURL:               c.baseURL,
+		disableRetries:        c.disableRetries,
+		transport:             c.transport,
+		authType:              c.authType,
+		username:              c.username,
+		password:              c.password,
+		token:                 token,
+		tokenSource:           c.tokenSource,
+		defaultRequestOptions: defaultRequestOptions,
+		defaultAccessLevel:    c.defaultAccessLevel,
+		requestHook:           c.requestHook,
+		responseHook:          c.responseHook,
+		UserAgent:             c.UserAgent,
+	}
+	clone.parent = c
+	if c.parent != nil {
+		clone.parent = c.parent
+	}
+	clone.initServices()
+
+	return clone
+}
+
+// initServices creates all the services of the client.
+func (c *Client) initServices() {
 	// Create the internal timeStats service.
 	timeStats := &timeStatsService{client: c}
 
@@ -374,11 +520,13 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.ErrorTracking = &ErrorTrackingService{client: c}
 	c.Events = &EventsService{client: c}
 	c.ExternalStatusChecks = &ExternalStatusChecksService{client: c}
+	c.FeatureFlagUserLists = &FeatureFlagUserListsService{client: c}
 	c.Features = &FeaturesService{client: c}
 	c.FreezePeriods = &FreezePeriodsService{client: c}
 	c.GenericPackages = &GenericPackagesService{client: c}
 	c.GeoNodes = &GeoNodesService{client: c}
 	c.GitIgnoreTemplates = &GitIgnoreTemplatesService{client: c}
+	c.GraphQL = &GraphQLClient{client: c}
 	c.GroupAccessTokens = &GroupAccessTokensService{client: c}
 	c.GroupBadges = &GroupBadgesService{client: c}
 	c.GroupCluster = &GroupClustersService{client: c}
@@ -470,30 +618,55 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Validate = &ValidateService{client: c}
 	c.Version = &VersionService{client: c}
 	c.Wikis = &WikisService{client: c}
-
-	return c, nil
 }
 
 // retryHTTPCheck provides a callback for Client.CheckRetry which
-// will retry both rate limit (429) and server (>= 500) errors.
+// will retry both rate limit (429) and server (>= 500) errors, unless
+// retries are disabled. When only idempotent requests should be retried,
+// server errors of other requests are not retried.
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, err := retryHTTPCheck(ctx, resp, err)
+	if retry && c.idempotentRetries && resp.StatusCode >= 500 && !isIdempotent(resp.Request) {
+		return false, err
+	}
+	return retry && !c.disableRetries, err
+}
+
+// retryHTTPCheck provides a callback for CheckRetry which will retry both
+// rate limit (429) and server (>= 500) errors.
+func retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
 	if err != nil {
 		return false, err
 	}
-	if !c.disableRetries && (resp.StatusCode == 429 || resp.StatusCode >= 500) {
+	if resp.StatusCode == 429 || resp.StatusCode >= 500 {
 		return true, nil
 	}
 	return false, nil
 }
 
+// isIdempotent reports whether req can safely be sent again after a server
+// error. Requests are idempotent when they use the GET or HEAD method, or
+// when they carry an Idempotency-Key header.
+func isIdempotent(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
-func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-	// Use the rate limit backoff function when we are rate limited.
-	if resp != nil && resp.StatusCode == 429 {
+func retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	// Use the rate limit backoff function when we are rate limited, or when
+	// the service is unavailable and tells us how long to wait.
+	if waitForRetryHeaders(resp) {
 		return rateLimitBackoff(min, max, attemptNum, resp)
 	}
 
@@ -504,9 +677,20 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 	return retryablehttp.LinearJitterBackoff(min, max, attemptNum, resp)
 }
 
+// waitForRetryHeaders reports whether the time to wait before retrying resp
+// should be taken from its headers, which is the case for rate limited
+// responses and unavailable services telling us how long to wait.
+func waitForRetryHeaders(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get(headerRetryAfter) != ""
+}
+
 // rateLimitBackoff provides a callback for Client.Backoff which will use the
-// RateLimit-Reset header to determine the time to wait. We add some jitter
-// to prevent a thundering herd.
+// RateLimit-Reset or Retry-After header to determine the time to wait. We add
+// some jitter to prevent a thundering herd.
 //
 // min and max are mainly used for bounding the jitter that will be added to
 // the reset time retrieved from the headers. But if the final wait time is
@@ -526,8 +710,20 @@ func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Respons
 					min = wait
 				}
 			}
+		} else if v := resp.Header.Get(headerRetryAfter); v != "" {
+			if retryAfter, _ := strconv.ParseInt(v, 10, 64); retryAfter > 0 {
+				// Only update min if the given time to wait is longer.
+				if wait := time.Duration(retryAfter) * time.Second; wait > min {
+					min = wait
+				}
+			} else if t, err := http.ParseTime(v); err == nil {
+				// Only update min if the given time to wait is longer.
+				if wait := time.Until(t); wait > min {
+					min = wait
+				}
+			}
 		} else {
-			// In case the RateLimit-Reset header is not set, back off an additional
+			// In case no rate limit headers are set, back off an additional
 			// 100% exponentially. With the default milliseconds being set to 100 for
 			// `min`, this makes the 5th retry wait 3.2 seconds (3,200 ms) by default.
 			min = time.Duration(float64(min) * math.Pow(2, float64(attemptNum)))
@@ -574,8 +770,23 @@ func (c *Client) BaseURL() *url.URL {
 	return &u
 }
 
+// SetBaseURL sets the base URL for API requests to a custom endpoint. The URL
+// is normalized the same way as with WithBaseURL: a missing scheme defaults to
+// https and the API version path is appended when missing.
+//
+// SetBaseURL is not safe to call concurrently with requests made by the client.
+func (c *Client) SetBaseURL(urlStr string) error {
+	return c.setBaseURL(urlStr)
+}
+
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
+	// Default to https when no scheme is given, as url.Parse would
+	// otherwise treat the host as part of the path.
+	if !strings.Contains(urlStr, "://") {
+		urlStr = "https://" + urlStr
+	}
+
 	// Make sure the given URL end with a slash
 	if !strings.HasSuffix(urlStr, "/") {
 		urlStr += "/"
@@ -612,6 +823,14 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 	u.RawPath = c.baseURL.Path + path
 	u.Path = c.baseURL.Path + unescaped
 
+	return c.newRequest(method, u, opt, options)
+}
+
+// newRequest creates a new API request for the given absolute URL. See
+// NewRequest for details.
+func (c *Client) newRequest(method string, u url.URL, opt interface{}, options []RequestOptionFunc) (*retryablehttp.Request, error) {
+	var err error
+
 	// Create a request specific headers map.
 	reqHeaders := make(http.Header)
 	reqHeaders.Set("Accept", "application/json")
@@ -644,6 +863,13 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 		return nil, err
 	}
 
+	// Set the request specific headers.
+	for k, v := range reqHeaders {
+		req.Header[k] = v
+	}
+
+	// Apply the request options after setting the request specific headers,
+	// so options are able to override them.
 	for _, fn := range append(c.defaultRequestOptions, options...) {
 		if fn == nil {
 			continue
@@ -653,11 +879,6 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 		}
 	}
 
-	// Set the request specific headers.
-	for k, v := range reqHeaders {
-		req.Header[k] = v
-	}
-
 	return req, nil
 }
 
@@ -693,10 +914,16 @@ func (c *Client) UploadRequest(method, path string, content io.Reader, filename
 		return nil, err
 	}
 
+	// Remember where the file is stored in the body, to be able to report
+	// the progress of the upload.
+	fileStart := int64(b.Len())
+
 	if _, err := io.Copy(fw, content); err != nil {
 		return nil, err
 	}
 
+	fileEnd := int64(b.Len())
+
 	if opt != nil {
 		fields, err := query.Values(opt)
 		if err != nil {
@@ -720,6 +947,13 @@ func (c *Client) UploadRequest(method, path string, content io.Reader, filename
 		return nil, err
 	}
 
+	// Set the request specific headers.
+	for k, v := range reqHeaders {
+		req.Header[k] = v
+	}
+
+	// Apply the request options after setting the request specific headers,
+	// so options are able to override them.
 	for _, fn := range append(c.defaultRequestOptions, options...) {
 		if fn == nil {
 			continue
@@ -729,14 +963,46 @@ func (c *Client) UploadRequest(method, path string, content io.Reader, filename
 		}
 	}
 
-	// Set the request specific headers.
-	for k, v := range reqHeaders {
-		req.Header[k] = v
+	// Report the progress of the upload when requested. The body is not
+	// copied, so the file is only buffered once.
+	if fn, ok := req.Context().Value(uploadProgressKey{}).(func(int64)); ok && fn != nil {
+		body := b.Bytes()
+		err := req.SetBody(retryablehttp.ReaderFunc(func() (io.Reader, error) {
+			return &progressReader{Reader: bytes.NewReader(body), fn: fn, start: fileStart, end: fileEnd}, nil
+		}))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return req, nil
 }
 
+// progressReader reads the multipart body of an upload and reports the number
+// of bytes read of the file, which is stored from start to end in the body.
+type progressReader struct {
+	*bytes.Reader
+	fn    func(bytesSent int64)
+	start int64
+	end   int64
+	sent  int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+
+	sent := r.Size() - int64(r.Len()) - r.start
+	if sent > r.end-r.start {
+		sent = r.end - r.start
+	}
+	if sent > r.sent {
+		r.sent = sent
+		r.fn(sent)
+	}
+
+	return n, err
+}
+
 // Response is a GitLab API response. This wraps the standard http.Response
 // returned from GitLab and provides convenient access to things like
 // pagination links.
@@ -756,6 +1022,18 @@ type Response struct {
 	NextLink     string
 	FirstLink    string
 	LastLink     string
+
+	// Fields used for rate limiting. They are only set when the
+	// corresponding headers are part of the response.
+	RateLimit          int
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+	RetryAfter         *time.Duration
+
+	// Fields used for conditional requests. NotModified is set when the
+	// resource did not change since the ETag given with WithIfNoneMatch.
+	ETag        string
+	NotModified bool
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -763,6 +1041,9 @@ func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
 	response.populateLinkValues()
+	response.populateRateLimitValues()
+	response.ETag = r.Header.Get("ETag")
+	response.NotModified = r.StatusCode == http.StatusNotModified
 	return response
 }
 
@@ -805,27 +1086,101 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// populateLinkValues parses the HTTP Link response headers and populates the
+// various keyset-based pagination link values in the Response.
 func (r *Response) populateLinkValues() {
-	if link := r.Header.Get("Link"); link != "" {
-		for _, link := range strings.Split(link, ",") {
-			parts := strings.Split(link, ";")
-			if len(parts) < 2 {
-				continue
+	for rel, link := range parseLinkHeader(r.Header.Values("Link")) {
+		switch rel {
+		case linkPrev:
+			r.PreviousLink = link
+		case linkNext:
+			r.NextLink = link
+		case linkFirst:
+			r.FirstLink = link
+		case linkLast:
+			r.LastLink = link
+		}
+	}
+}
+
+// LinkForRel returns the target of the link with the given relation type from
+// the HTTP Link response headers, or an empty string if there is no such link.
+func (r *Response) LinkForRel(rel string) string {
+	return parseLinkHeader(r.Header.Values("Link"))[rel]
+}
+
+// parseLinkHeader parses the given HTTP Link header values and returns a map
+// of the link targets keyed by their relation types.
+func parseLinkHeader(values []string) map[string]string {
+	links := make(map[string]string)
+
+	for _, header := range values {
+		for {
+			start := strings.IndexByte(header, '<')
+			end := strings.IndexByte(header, '>')
+			if start < 0 || end < start {
+				break
 			}
+			target := header[start+1 : end]
+			header = header[end+1:]
+
+			// The parameters of a link value end at the first comma that
+			// isn't part of a quoted string, or at the end of the header.
+			params := header
+			header = ""
+			inQuotes := false
+			for i, ch := range params {
+				if ch == '"' {
+					inQuotes = !inQuotes
+				}
+				if ch == ',' && !inQuotes {
+					params, header = params[:i], params[i+1:]
+					break
+				}
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				key, value, ok := strings.Cut(param, "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				// A single link can have multiple space separated relation types.
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+					links[rel] = target
+				}
+			}
+		}
+	}
+
+	return links
+}
 
-			linkType := strings.Trim(strings.Split(parts[1], "=")[1], "\"")
-			linkValue := strings.Trim(parts[0], "< >")
-
-			switch linkType {
-			case linkPrev:
-				r.PreviousLink = linkValue
-			case linkNext:
-				r.NextLink = linkValue
-			case linkFirst:
-				r.FirstLink = linkValue
-			case linkLast:
-				r.LastLink = linkValue
+// populateRateLimitValues parses the HTTP rate limit response headers and
+// populates the various rate limit values in the Response.
+func (r *Response) populateRateLimitValues() {
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		r.RateLimit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		r.RateLimitRemaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, err := strconv.ParseInt(reset, 10, 64); err == nil && v > 0 {
+			r.RateLimitReset = time.Unix(v, 0)
+		}
+	}
+	if retryAfter := r.Header.Get(headerRetryAfter); retryAfter != "" {
+		// The Retry-After header contains either a number of seconds to wait
+		// or a HTTP date after which the request can be retried.
+		if v, err := strconv.Atoi(retryAfter); err == nil && v >= 0 {
+			wait := time.Duration(v) * time.Second
+			r.RetryAfter = &wait
+		} else if t, err := http.ParseTime(retryAfter); err == nil {
+			wait := time.Until(t)
+			if wait < 0 {
+				wait = 0
 			}
+			r.RetryAfter = &wait
 		}
 	}
 }
@@ -836,8 +1191,87 @@ func (r *Response) populateLinkValues() {
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error) {
+	req, cancel := withRequestTimeout(req)
+	defer cancel()
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	defer io.Copy(io.Discard, resp.Body)
+
+	response := newResponse(resp)
+
+	err = CheckResponse(resp)
+	if err != nil {
+		// Even though there was an error, we still return the response
+		// in case the caller wants to inspect it further.
+		return response, err
+	}
+
+	// A not modified response has no body, so v is left untouched.
+	if v != nil && !response.NotModified {
+		if w, ok := v.(io.Writer); ok {
+			_, err = io.Copy(w, resp.Body)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(v)
+		}
+	}
+
+	return response, err
+}
+
+// doStream sends an API request and returns the raw response body without
+// reading it, so large responses can be consumed incrementally. The caller
+// is responsible for closing the returned body.
+func (c *Client) doStream(req *retryablehttp.Request) (io.ReadCloser, *Response, error) {
+	// The timeout context is released once the body is closed, as the body
+	// can only be read while the context is still valid.
+	req, cancel := withRequestTimeout(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	response := newResponse(resp)
+
+	err = CheckResponse(resp)
+	if err != nil {
+		resp.Body.Close()
+		cancel()
+		// Even though there was an error, we still return the response
+		// in case the caller wants to inspect it further.
+		return nil, response, err
+	}
+
+	return &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}, response, nil
+}
+
+// withRequestTimeout returns a copy of req with the deadline set by
+// WithRequestTimeout attached to its context, and the function releasing it.
+func withRequestTimeout(req *retryablehttp.Request) (*retryablehttp.Request, context.CancelFunc) {
+	timeout, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration)
+	if !ok {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// do sends an API request after waiting for the rate limiter and setting the
+// correct authentication headers, and returns the raw HTTP response.
+func (c *Client) do(req *retryablehttp.Request) (*http.Response, error) {
+	// Copies made by WithSudo use the rate limiter of their parent.
+	lc := c
+	if c.parent != nil {
+		lc = c.parent
+	}
+
 	// Wait will block until the limiter can obtain a new token.
-	err := c.limiter.Wait(req.Context())
+	err := lc.limiter.Wait(req.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -864,7 +1298,15 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 	case OAuthToken:
 		if values := req.Header.Values("Authorization"); len(values) == 0 {
-			req.Header.Set("Authorization", "Bearer "+c.token)
+			if c.tokenSource == nil {
+				req.Header.Set("Authorization", "Bearer "+c.token)
+				break
+			}
+			t, err := c.tokenSource.Token()
+			if err != nil {
+				return nil, err
+			}
+			t.SetAuthHeader(req.Request)
 		}
 	case PrivateToken:
 		if values := req.Header.Values("PRIVATE-TOKEN"); len(values) == 0 {
@@ -872,45 +1314,61 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
+	if c.requestHook != nil {
+		// Pass a clone so the hook cannot modify the request.
+		c.requestHook(req.Request.Clone(req.Context()))
 	}
 
-	if resp.StatusCode == http.StatusUnauthorized && c.authType == BasicAuth {
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && c.authType == BasicAuth {
 		resp.Body.Close()
-		// The token most likely expired, so we need to request a new one and try again.
-		if _, err := c.requestOAuthToken(req.Context(), basicAuthToken); err != nil {
-			return nil, err
-		}
-		return c.Do(req, v)
+		// The token most likely expired, so we need to request a new one and
+		// try again. This is still the same request, so the hooks are only
+		// called once.
+		resp, err = c.retryWithNewOAuthToken(req, lc, basicAuthToken)
+	}
+	if c.responseHook != nil {
+		c.responseHook(resp, time.Since(start))
+	}
+	if err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
-	defer io.Copy(io.Discard, resp.Body)
 
 	// If not yet configured, try to configure the rate limiter
 	// using the response headers we just received. Fail silently
 	// so the limiter will remain disabled in case of an error.
-	c.configureLimiterOnce.Do(func() { c.configureLimiter(req.Context(), resp.Header) })
+	lc.configureLimiterOnce.Do(func() { lc.configureLimiter(req.Context(), resp.Header) })
 
-	response := newResponse(resp)
+	return resp, nil
+}
 
-	err = CheckResponse(resp)
+// cancelReadCloser wraps a response body and cancels the context of the
+// request when the body is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelReadCloser) Close() error {
+	defer r.cancel()
+	return r.ReadCloser.Close()
+}
+
+// retryWithNewOAuthToken requests a new OAuth token to replace the expired
+// token and sends the request again using the new token.
+func (c *Client) retryWithNewOAuthToken(req *retryablehttp.Request, lc *Client, expired string) (*http.Response, error) {
+	token, err := c.requestOAuthToken(req.Context(), expired)
 	if err != nil {
-		// Even though there was an error, we still return the response
-		// in case the caller wants to inspect it further.
-		return response, err
+		return nil, err
 	}
+	req.Header.Set("Authorization", "Bearer "+token)
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-		}
+	if err := lc.limiter.Wait(req.Context()); err != nil {
+		return nil, err
 	}
 
-	return response, err
+	return c.client.Do(req)
 }
 
 func (c *Client) requestOAuthToken(ctx context.Context, token string) (string, error) {
@@ -978,10 +1436,42 @@ func (e *ErrorResponse) Error() string {
 	}
 }
 
+// IsNotFound reports whether err, or any error it wraps, indicates the GitLab
+// API responded with a 404 Not Found status.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err, or any error it wraps, is an
+// *ErrorResponse with a 401 Unauthorized status.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err, or any error it wraps, is an
+// *ErrorResponse with a 403 Forbidden status.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err, or any error it wraps, is an
+// *ErrorResponse with a 409 Conflict status.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == statusCode
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 func CheckResponse(r *http.Response) error {
 	switch r.StatusCode {
-	case 200, 201, 202, 204, 304:
+	case 200, 201, 202, 204, 206, 304:
 		return nil
 	case 404:
 		return ErrNotFound
@@ -26,11 +26,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
 )
 
 var timeLayout = "2006-01-02T15:04:05Z07:00"
@@ -122,6 +125,281 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestSetBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{"full", "https://gitlab.example.com/api/v4/", "https://gitlab.example.com/api/v4/"},
+		{"without trailing slash", "https://gitlab.example.com/api/v4", "https://gitlab.example.com/api/v4/"},
+		{"without api path", "https://gitlab.example.com", "https://gitlab.example.com/api/v4/"},
+		{"with sub path", "http://example.com/gitlab/", "http://example.com/gitlab/api/v4/"},
+		{"without scheme", "gitlab.example.com", "https://gitlab.example.com/api/v4/"},
+		{"without scheme with port", "gitlab.example.com:8443/", "https://gitlab.example.com:8443/api/v4/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient("")
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			if err := c.SetBaseURL(tt.baseURL); err != nil {
+				t.Fatalf("SetBaseURL returned error: %v", err)
+			}
+			if c.BaseURL().String() != tt.want {
+				t.Errorf("BaseURL is %s, want %s", c.BaseURL().String(), tt.want)
+			}
+
+			req, err := c.NewRequest(http.MethodGet, "projects", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+			if req.URL.String() != tt.want+"projects" {
+				t.Errorf("Request URL is %s, want %s", req.URL.String(), tt.want+"projects")
+			}
+		})
+	}
+}
+
+func TestBaseURLReturnsCopy(t *testing.T) {
+	c, err := NewClient("", WithBaseURL("https://gitlab.example.com"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	u := c.BaseURL()
+	u.Host = "other.example.com"
+
+	if c.BaseURL().Host != "gitlab.example.com" {
+		t.Errorf("BaseURL host is %s, want gitlab.example.com", c.BaseURL().Host)
+	}
+}
+
+func TestClientWithSudo(t *testing.T) {
+	mux, client := setup(t)
+
+	var mu sync.Mutex
+	got := map[string][]string{}
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		got[r.URL.Path] = append(got[r.URL.Path], r.Header.Get("SUDO"))
+		mu.Unlock()
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	alice := client.WithSudo("alice")
+	bob := client.WithSudo(2)
+
+	if alice.Projects == client.Projects || alice.Projects == bob.Projects {
+		t.Fatal("Expected WithSudo to return a client with its own services")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, _, err := alice.Projects.GetProject("alice/project", nil); err != nil {
+				t.Errorf("Projects.GetProject returned error: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, _, err := bob.Projects.GetProject("bob/project", nil); err != nil {
+				t.Errorf("Projects.GetProject returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, _, err := client.Projects.GetProject("root/project", nil); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	// A sudo user passed with the request takes precedence.
+	if _, _, err := alice.Projects.GetProject("carol/project", nil, WithSudo("carol")); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	for path, want := range map[string]string{
+		"/api/v4/projects/alice/project": "alice",
+		"/api/v4/projects/bob/project":   "2",
+		"/api/v4/projects/root/project":  "",
+		"/api/v4/projects/carol/project": "carol",
+	} {
+		if len(got[path]) == 0 {
+			t.Errorf("No requests received for %s", path)
+		}
+		for _, sudo := range got[path] {
+			if sudo != want {
+				t.Errorf("Request for %s sent SUDO header %q, want %q", path, sudo, want)
+			}
+		}
+	}
+}
+
+type countingLimiter struct {
+	mu    sync.Mutex
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waits++
+	return nil
+}
+
+func TestClientWithSudoSharesLimiter(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "600")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	limiter := &countingLimiter{}
+	client, err := NewClient("", WithBaseURL(server.URL), WithCustomLimiter(limiter))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	alice := client.WithSudo("alice")
+	bob := alice.WithSudo("bob")
+
+	for _, c := range []*Client{alice, alice, alice, bob, client} {
+		if _, _, err := c.Projects.GetProject(1, nil); err != nil {
+			t.Fatalf("Projects.GetProject returned error: %v", err)
+		}
+	}
+
+	if limiter.waits != 5 {
+		t.Errorf("Custom limiter was called %d times, want 5", limiter.waits)
+	}
+}
+
+type rotatingTokenSource struct {
+	tokens []string
+	calls  int
+}
+
+func (ts *rotatingTokenSource) Token() (*oauth2.Token, error) {
+	if ts.calls >= len(ts.tokens) {
+		return nil, errors.New("no more tokens")
+	}
+	t := &oauth2.Token{AccessToken: ts.tokens[ts.calls]}
+	ts.calls++
+	return t, nil
+}
+
+func TestNewOAuthClientWithTokenSource(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var got []string
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ts := &rotatingTokenSource{tokens: []string{"token-1", "token-2"}}
+	client, err := NewOAuthClientWithTokenSource(ts, WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Projects.GetProject(1, nil); err != nil {
+			t.Fatalf("Projects.GetProject returned error: %v", err)
+		}
+	}
+
+	// A token passed with the request takes precedence over the token source.
+	if _, _, err := client.Projects.GetProject(1, nil, WithToken(OAuthToken, "override")); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	want := []string{"Bearer token-1", "Bearer token-2", "Bearer override"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected Authorization headers %v, got %v", want, got)
+	}
+
+	// Errors of the token source are returned to the caller.
+	if _, _, err := client.Projects.GetProject(1, nil); err == nil {
+		t.Fatal("Expected an error when the token source fails")
+	}
+}
+
+func TestErrorStatusHelpers(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodGet, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	errorFor := func(statusCode int) error {
+		return CheckResponse(&http.Response{
+			Request:    req.Request,
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(`{"message":"error"}`)),
+		})
+	}
+
+	helpers := map[string]struct {
+		fn         func(error) bool
+		statusCode int
+	}{
+		"IsNotFound":     {IsNotFound, http.StatusNotFound},
+		"IsUnauthorized": {IsUnauthorized, http.StatusUnauthorized},
+		"IsForbidden":    {IsForbidden, http.StatusForbidden},
+		"IsConflict":     {IsConflict, http.StatusConflict},
+	}
+
+	statusCodes := []int{
+		http.StatusBadRequest,
+		http.StatusUnauthorized,
+		http.StatusForbidden,
+		http.StatusNotFound,
+		http.StatusConflict,
+		http.StatusInternalServerError,
+	}
+
+	for name, h := range helpers {
+		t.Run(name, func(t *testing.T) {
+			for _, statusCode := range statusCodes {
+				err := errorFor(statusCode)
+				want := statusCode == h.statusCode
+
+				if got := h.fn(err); got != want {
+					t.Errorf("%s(%d) returned %v, want %v", name, statusCode, got, want)
+				}
+				if got := h.fn(fmt.Errorf("wrapped: %w", err)); got != want {
+					t.Errorf("%s(wrapped %d) returned %v, want %v", name, statusCode, got, want)
+				}
+			}
+
+			if h.fn(nil) {
+				t.Errorf("%s(nil) returned true, want false", name)
+			}
+			if h.fn(errors.New("some other error")) {
+				t.Errorf("%s(non-API error) returned true, want false", name)
+			}
+			if h.fn(fmt.Errorf("wrapped: %w", context.Canceled)) {
+				t.Errorf("%s(wrapped non-API error) returned true, want false", name)
+			}
+		})
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
@@ -419,6 +697,123 @@ func TestPaginationPopulatePageValuesKeyset(t *testing.T) {
 	}
 }
 
+func TestPaginationPopulateLinkValuesWithCommas(t *testing.T) {
+	h := http.Header{}
+	h.Add("Link", `<https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=1>; rel="prev first", `+
+		`<https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=3>; title="next, please"; rel="next"`)
+	h.Add("Link", `<https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=5>; rel=last`)
+
+	r := newResponse(&http.Response{
+		Header: h,
+	})
+
+	want := map[string]string{
+		linkPrev:  "https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=1",
+		linkFirst: "https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=1",
+		linkNext:  "https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=3",
+		linkLast:  "https://gitlab.example.com/api/v4/projects/8/issues?labels=bug,critical&page=5",
+	}
+	got := map[string]string{
+		linkPrev:  r.PreviousLink,
+		linkFirst: r.FirstLink,
+		linkNext:  r.NextLink,
+		linkLast:  r.LastLink,
+	}
+	for k, v := range want {
+		if v != got[k] {
+			t.Errorf("For %s, expected %s, got %s", k, v, got[k])
+		}
+		if link := r.LinkForRel(k); v != link {
+			t.Errorf("LinkForRel(%q) expected %s, got %s", k, v, link)
+		}
+	}
+
+	if link := r.LinkForRel("unknown"); link != "" {
+		t.Errorf("LinkForRel(%q) expected an empty string, got %s", "unknown", link)
+	}
+}
+
+func TestRateLimitPopulateValuesEmpty(t *testing.T) {
+	r := newResponse(&http.Response{
+		Header: http.Header{},
+	})
+
+	if r.RateLimit != 0 {
+		t.Errorf("Expected RateLimit 0, got %d", r.RateLimit)
+	}
+	if r.RateLimitRemaining != 0 {
+		t.Errorf("Expected RateLimitRemaining 0, got %d", r.RateLimitRemaining)
+	}
+	if !r.RateLimitReset.IsZero() {
+		t.Errorf("Expected zero RateLimitReset, got %s", r.RateLimitReset)
+	}
+	if r.RetryAfter != nil {
+		t.Errorf("Expected nil RetryAfter, got %s", *r.RetryAfter)
+	}
+}
+
+func TestRateLimitPopulateValuesDeltaSeconds(t *testing.T) {
+	h := http.Header{}
+	h.Set(headerRateLimit, "600")
+	h.Set(headerRateRemaining, "0")
+	h.Set(headerRateReset, "1609844400")
+	h.Set(headerRetryAfter, "60")
+
+	r := newResponse(&http.Response{
+		Header: h,
+	})
+
+	if r.RateLimit != 600 {
+		t.Errorf("Expected RateLimit 600, got %d", r.RateLimit)
+	}
+	if r.RateLimitRemaining != 0 {
+		t.Errorf("Expected RateLimitRemaining 0, got %d", r.RateLimitRemaining)
+	}
+	if want := time.Unix(1609844400, 0); !r.RateLimitReset.Equal(want) {
+		t.Errorf("Expected RateLimitReset %s, got %s", want, r.RateLimitReset)
+	}
+	if r.RetryAfter == nil || *r.RetryAfter != 60*time.Second {
+		t.Errorf("Expected RetryAfter 60s, got %v", r.RetryAfter)
+	}
+}
+
+func TestRateLimitPopulateValuesHTTPDate(t *testing.T) {
+	h := http.Header{}
+	h.Set(headerRetryAfter, time.Now().Add(2*time.Minute).UTC().Format(http.TimeFormat))
+
+	r := newResponse(&http.Response{
+		Header: h,
+	})
+
+	if r.RetryAfter == nil {
+		t.Fatal("Expected RetryAfter to be set")
+	}
+	if *r.RetryAfter <= time.Minute || *r.RetryAfter > 2*time.Minute {
+		t.Errorf("Expected RetryAfter between 1m and 2m, got %s", *r.RetryAfter)
+	}
+}
+
+func TestRateLimitPopulateValuesInvalid(t *testing.T) {
+	h := http.Header{}
+	h.Set(headerRateLimit, "unlimited")
+	h.Set(headerRateReset, "soon")
+	h.Set(headerRetryAfter, "later")
+
+	r := newResponse(&http.Response{
+		Header: h,
+	})
+
+	if r.RateLimit != 0 {
+		t.Errorf("Expected RateLimit 0, got %d", r.RateLimit)
+	}
+	if !r.RateLimitReset.IsZero() {
+		t.Errorf("Expected zero RateLimitReset, got %s", r.RateLimitReset)
+	}
+	if r.RetryAfter != nil {
+		t.Errorf("Expected nil RetryAfter, got %s", *r.RetryAfter)
+	}
+}
+
 func TestExponentialBackoffLogic(t *testing.T) {
 	// Can't use the default `setup` because it disabled the backoff
 	mux := http.NewServeMux()
@@ -454,3 +849,518 @@ func TestExponentialBackoffLogic(t *testing.T) {
 		t.Fatal("Expected to get a 429 code given the server is hard-coded to return this. Received instead:", resp.StatusCode)
 	}
 }
+
+func TestRetryAfterBackoffLogic(t *testing.T) {
+	// Can't use the default `setup` because it disabled the backoff
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		attempts++
+		if attempts == 1 {
+			w.Header().Set(headerRetryAfter, "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	start := time.Now()
+	_, resp, err := client.Projects.GetProject(1, nil)
+	duration := time.Since(start)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status code 200, got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+
+	// The wait should be at least the Retry-After value. The exact bounds of
+	// the jitter are tested by TestRetryHTTPBackoffRetryAfter, so the upper
+	// bound only guards against ignoring the header altogether.
+	if duration < time.Second || duration > 5*time.Second {
+		t.Fatalf("Expected a wait between 1s and 5s, got %s", duration)
+	}
+}
+
+func TestRetryHTTPBackoffRetryAfter(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		resp.Header.Set(headerRetryAfter, "2")
+
+		for i := 0; i < 10; i++ {
+			wait := retryHTTPBackoff(defaultRetryWaitMin, defaultRetryWaitMax, 0, resp)
+			if wait < 2*time.Second || wait > 2*time.Second+defaultRetryWaitMax-defaultRetryWaitMin {
+				t.Fatalf("Backoff for %d with Retry-After is %s, want between 2s and 2.3s", status, wait)
+			}
+		}
+	}
+
+	// Without a Retry-After header the default backoff is used for 503.
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	if wait := retryHTTPBackoff(defaultRetryWaitMin, defaultRetryWaitMax, 0, resp); wait > time.Second {
+		t.Fatalf("Backoff for 503 without Retry-After is %s, want at most 1s", wait)
+	}
+}
+
+func TestRetryRewindsRequestBody(t *testing.T) {
+	mux, client := setup(t)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"test"}`)
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"test"}`)
+	})
+
+	p, _, err := client.Projects.CreateProject(&CreateProjectOptions{Name: Ptr("test")})
+	if err != nil {
+		t.Fatalf("Projects.CreateProject returned error: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+	if p.ID != 1 {
+		t.Fatalf("Expected project ID 1, got %d", p.ID)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	methods := []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+	tests := []struct {
+		name    string
+		options []ClientOptionFunc
+		want    map[string]int
+	}{
+		{
+			name: "all requests",
+			want: map[string]int{
+				http.MethodGet:    defaultRetryMax + 1,
+				http.MethodHead:   defaultRetryMax + 1,
+				http.MethodPost:   defaultRetryMax + 1,
+				http.MethodPut:    defaultRetryMax + 1,
+				http.MethodPatch:  defaultRetryMax + 1,
+				http.MethodDelete: defaultRetryMax + 1,
+			},
+		},
+		{
+			name:    "idempotent requests",
+			options: []ClientOptionFunc{WithIdempotentRetries()},
+			want: map[string]int{
+				http.MethodGet:    defaultRetryMax + 1,
+				http.MethodHead:   defaultRetryMax + 1,
+				http.MethodPost:   1,
+				http.MethodPut:    1,
+				http.MethodPatch:  1,
+				http.MethodDelete: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			attempts := make(map[string]int)
+			mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+				attempts[r.Method]++
+				w.WriteHeader(http.StatusServiceUnavailable)
+			})
+
+			options := append([]ClientOptionFunc{
+				WithBaseURL(server.URL),
+				WithCustomBackoff(func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+					return 0
+				}),
+			}, tt.options...)
+
+			client, err := NewClient("", options...)
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			for _, method := range methods {
+				req, err := client.NewRequest(method, "projects/1", nil, nil)
+				if err != nil {
+					t.Fatalf("Failed to create request: %v", err)
+				}
+				if _, err := client.Do(req, nil); err == nil {
+					t.Fatalf("Expected an error for %s", method)
+				}
+			}
+
+			if !reflect.DeepEqual(attempts, tt.want) {
+				t.Errorf("Attempts are %v, want %v", attempts, tt.want)
+			}
+		})
+	}
+}
+
+func TestIdempotentRetriesWithIdempotencyKey(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"test"}`)
+	})
+
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithIdempotentRetries(),
+		WithCustomBackoff(func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+			return 0
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, _, err = client.Projects.CreateProject(&CreateProjectOptions{Name: Ptr("test")}, WithIdempotencyKey("key"))
+	if err != nil {
+		t.Fatalf("Projects.CreateProject returned error: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRequestAndResponseHooks(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Hook") != "" {
+			t.Error("Request hook was able to modify the request")
+		}
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var requests []string
+	var responses []int
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithCustomBackoff(func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+			return 0
+		}),
+		WithRequestHook(func(r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			r.Header.Set("X-Hook", "modified")
+		}),
+		WithResponseHook(func(r *http.Response, d time.Duration) {
+			if d <= 0 {
+				t.Errorf("Expected a positive duration, got %s", d)
+			}
+			responses = append(responses, r.StatusCode)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Projects.GetProject(1, nil); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+
+	if _, _, err := client.Projects.GetProject(2, nil); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+
+	wantRequests := []string{"GET /api/v4/projects/1", "GET /api/v4/projects/2"}
+	if !reflect.DeepEqual(wantRequests, requests) {
+		t.Errorf("Expected request hook calls %v, got %v", wantRequests, requests)
+	}
+	wantResponses := []int{http.StatusOK, http.StatusNotFound}
+	if !reflect.DeepEqual(wantResponses, responses) {
+		t.Errorf("Expected response hook calls %v, got %v", wantResponses, responses)
+	}
+}
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (rt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRequestAndResponseHooksBasicAuthRefresh(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var tokens int
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		tokens++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer"}`, tokens)
+	})
+
+	var attempts int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// The first token is expired.
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	var requests, responses int
+	var lastStatus int
+	client, err := NewBasicAuthClient("user", "password",
+		WithBaseURL(server.URL),
+		WithRequestHook(func(r *http.Request) {
+			requests++
+		}),
+		WithResponseHook(func(r *http.Response, d time.Duration) {
+			responses++
+			lastStatus = r.StatusCode
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Projects.GetProject(1, nil); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+	if tokens != 2 {
+		t.Errorf("Expected 2 token requests, got %d", tokens)
+	}
+	if requests != 1 || responses != 1 {
+		t.Errorf("Expected the hooks to be called once, got %d request and %d response hook calls", requests, responses)
+	}
+	if lastStatus != http.StatusOK {
+		t.Errorf("Expected the response hook to get the final response, got status %d", lastStatus)
+	}
+}
+
+func TestWithHTTPTransport(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	rt := &recordingRoundTripper{}
+	client, err := NewClient("secret",
+		WithBaseURL(server.URL),
+		WithHTTPTransport(rt),
+		WithCustomBackoff(func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+			return 0
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Projects.GetProject(1, nil); err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	// The failed attempt must have been retried through the custom transport.
+	if len(rt.requests) != 2 {
+		t.Fatalf("Expected 2 requests through the custom transport, got %d", len(rt.requests))
+	}
+	for _, req := range rt.requests {
+		if got := req.Header.Get("PRIVATE-TOKEN"); got != "secret" {
+			t.Errorf("PRIVATE-TOKEN header is %q, want %q", got, "secret")
+		}
+	}
+}
+
+func TestWithHTTPTransportAndHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	rt := &recordingRoundTripper{}
+
+	for name, options := range map[string][]ClientOptionFunc{
+		"transport first": {WithHTTPTransport(rt), WithHTTPClient(httpClient)},
+		"client first":    {WithHTTPClient(httpClient), WithHTTPTransport(rt)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, err := NewClient("", options...)
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			got := client.client.HTTPClient
+			if got.Transport != rt {
+				t.Errorf("Transport is %T, want the custom transport", got.Transport)
+			}
+			if got.Timeout != httpClient.Timeout {
+				t.Errorf("Timeout is %s, want %s", got.Timeout, httpClient.Timeout)
+			}
+			if httpClient.Transport != nil {
+				t.Errorf("The given HTTP client was modified")
+			}
+		})
+	}
+}
+
+func TestDoDecodesJSONIntoStruct(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"name":"project"}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "projects/1", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	var p struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	if _, err := client.Do(req, &p); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if p.ID != 1 || p.Name != "project" {
+		t.Errorf("Do decoded %+v, want ID 1 and name project", p)
+	}
+}
+
+func TestDoWritesRawBodyToWriter(t *testing.T) {
+	mux, client := setup(t)
+
+	script := "#!/bin/sh\nset -e\necho \"not JSON\"\n"
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, script)
+	})
+
+	for name, newWriter := range map[string]func() (io.Writer, func() string){
+		"bytes.Buffer": func() (io.Writer, func() string) {
+			b := new(bytes.Buffer)
+			return b, b.String
+		},
+		"io.Writer": func() (io.Writer, func() string) {
+			sb := new(strings.Builder)
+			return sb, sb.String
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, err := client.NewRequest(http.MethodGet, "projects/1/snippets/2/raw", nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+
+			w, got := newWriter()
+			if _, err := client.Do(req, w); err != nil {
+				t.Fatalf("Do returned error: %v", err)
+			}
+			if got() != script {
+				t.Errorf("Do wrote %q, want %q", got(), script)
+			}
+		})
+	}
+}
+
+func TestDoNotModified(t *testing.T) {
+	mux, client := setup(t)
+
+	const etag = `W/"e2ba3f0d0ab4ea1e3a1f7d0b5a5f0d7c"`
+	mux.HandleFunc("/api/v4/projects/1/pipelines/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"id":2,"status":"running"}`)
+	})
+
+	pipeline, resp, err := client.Pipelines.GetPipeline(1, 2)
+	if err != nil {
+		t.Fatalf("Pipelines.GetPipeline returned error: %v", err)
+	}
+	if resp.NotModified {
+		t.Errorf("Response.NotModified is true, want false")
+	}
+	if resp.ETag != etag {
+		t.Errorf("Response.ETag is %q, want %q", resp.ETag, etag)
+	}
+
+	req, err := client.NewRequest(http.MethodGet, "projects/1/pipelines/2", nil, []RequestOptionFunc{WithIfNoneMatch(resp.ETag)})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	// The target is left untouched for a not modified response.
+	cached := *pipeline
+	resp, err = client.Do(req, pipeline)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if !resp.NotModified || resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Do returned status %d with NotModified %t, want 304 and true", resp.StatusCode, resp.NotModified)
+	}
+	if resp.ETag != etag {
+		t.Errorf("Response.ETag is %q, want %q", resp.ETag, etag)
+	}
+	if !reflect.DeepEqual(cached, *pipeline) {
+		t.Errorf("Do modified the target to %+v, want %+v", *pipeline, cached)
+	}
+}
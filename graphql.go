@@ -0,0 +1,129 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GraphQLClient handles communication with the GitLab GraphQL API. It uses
+// the same authentication, transport and retry logic as the REST API client.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLClient struct {
+	client *Client
+}
+
+// graphQLRequest represents the body of a GraphQL request.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents the body of a GraphQL response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQLError represents a single error returned by the GraphQL API.
+type GraphQLError struct {
+	Message   string `json:"message"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations"`
+	Path []interface{} `json:"path"`
+}
+
+func (e GraphQLError) Error() string {
+	return e.Message
+}
+
+// GraphQLErrors represents the errors returned by the GraphQL API. Queries
+// with errors may still return (partial) data.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Message)
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// Query executes a GraphQL query (or mutation) with the given variables and
+// decodes the data of the response into out. When the response contains
+// errors, the available data is still decoded into out and a GraphQLErrors
+// error is returned.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (g *GraphQLClient) Query(ctx context.Context, query string, vars map[string]interface{}, out interface{}, options ...RequestOptionFunc) error {
+	_, err := g.query(ctx, query, vars, out, options)
+	return err
+}
+
+// query executes a GraphQL query like Query, and also returns the response,
+// so it can be returned by the services using the GraphQL API.
+func (g *GraphQLClient) query(ctx context.Context, query string, vars map[string]interface{}, out interface{}, options []RequestOptionFunc) (*Response, error) {
+	body := &graphQLRequest{Query: query, Variables: vars}
+
+	// The GraphQL endpoint lives next to the versioned REST API, so the
+	// base URL "https://gitlab.example.com/api/v4/" results in the endpoint
+	// "https://gitlab.example.com/api/graphql".
+	u := g.client.baseURL.ResolveReference(&url.URL{Path: "../graphql"})
+
+	req, err := g.client.newRequest(http.MethodPost, *u, body, append([]RequestOptionFunc{WithContext(ctx)}, options...))
+	if err != nil {
+		return nil, err
+	}
+
+	var gr graphQLResponse
+	resp, err := g.client.Do(req, &gr)
+	if err != nil {
+		return resp, err
+	}
+
+	if out != nil && len(gr.Data) > 0 && string(gr.Data) != "null" {
+		if err := json.Unmarshal(gr.Data, out); err != nil {
+			return resp, err
+		}
+	}
+
+	if len(gr.Errors) > 0 {
+		return resp, gr.Errors
+	}
+
+	return resp, nil
+}
+
+// mutationErrors returns the errors of a GraphQL mutation, which are returned
+// as part of its data instead of as GraphQL errors, as a GraphQLErrors error.
+func mutationErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	ge := make(GraphQLErrors, 0, len(errs))
+	for _, msg := range errs {
+		ge = append(ge, GraphQLError{Message: msg})
+	}
+	return ge
+}
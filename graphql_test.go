@@ -0,0 +1,126 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLQuery(t *testing.T) {
+	mux, client := setup(t)
+
+	client, err := NewClient("secret", WithBaseURL(client.BaseURL().String()))
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"query":"query($path: ID!) { project(fullPath: $path) { id name } }","variables":{"path":"group/project"}}`)
+		assert.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		fmt.Fprint(w, `{"data":{"project":{"id":"gid://gitlab/Project/1","name":"project"}}}`)
+	})
+
+	var out struct {
+		Project struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"project"`
+	}
+
+	err = client.GraphQL.Query(
+		context.Background(),
+		"query($path: ID!) { project(fullPath: $path) { id name } }",
+		map[string]interface{}{"path": "group/project"},
+		&out,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "gid://gitlab/Project/1", out.Project.ID)
+	assert.Equal(t, "project", out.Project.Name)
+}
+
+func TestGraphQLQueryURLOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURL(t, r, "/api/graphql?trace=true")
+		fmt.Fprint(w, `{"data":{"currentUser":null}}`)
+	})
+
+	// Request options changing the URL are applied to the GraphQL endpoint.
+	withTrace := func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("trace", "true")
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+
+	err := client.GraphQL.Query(context.Background(), "{ currentUser { id } }", nil, nil, withTrace)
+	require.NoError(t, err)
+}
+
+func TestGraphQLQueryErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+			"data": {"project": null},
+			"errors": [
+				{"message": "Field 'foo' doesn't exist on type 'Project'", "locations": [{"line": 1, "column": 11}], "path": ["project", "foo"]}
+			]
+		}`)
+	})
+
+	var out struct {
+		Project *struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+
+	err := client.GraphQL.Query(context.Background(), "{ project { foo } }", nil, &out)
+
+	var gqlErrs GraphQLErrors
+	require.True(t, errors.As(err, &gqlErrs))
+	require.Len(t, gqlErrs, 1)
+	assert.Equal(t, "Field 'foo' doesn't exist on type 'Project'", gqlErrs[0].Message)
+	assert.Equal(t, 1, gqlErrs[0].Locations[0].Line)
+	assert.Equal(t, 11, gqlErrs[0].Locations[0].Column)
+	assert.EqualError(t, err, "graphql: Field 'foo' doesn't exist on type 'Project'")
+	assert.Nil(t, out.Project)
+}
+
+func TestGraphQLQueryHTTPError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"401 Unauthorized"}`)
+	})
+
+	err := client.GraphQL.Query(context.Background(), "{ currentUser { id } }", nil, nil)
+	assert.True(t, IsUnauthorized(err))
+}
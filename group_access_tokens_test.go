@@ -151,6 +151,7 @@ func TestRotateGroupAccessToken(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/groups/1/access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2023-08-15"}`)
 		mustWriteHTTPResponse(t, w, "testdata/rotate_group_access_token.json")
 	})
 
@@ -30,7 +30,7 @@ type GroupIterationsService struct {
 	client *Client
 }
 
-// GroupInteration represents a GitLab iteration.
+// GroupIteration represents a GitLab iteration.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/group_iterations.html
 type GroupIteration struct {
@@ -83,7 +83,7 @@ func (s *GroupIterationsService) ListGroupIterations(gid interface{}, opt *ListG
 	var gis []*GroupIteration
 	resp, err := s.client.Do(req, &gis)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return gis, resp, nil
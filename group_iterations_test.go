@@ -46,3 +46,28 @@ func TestListGroupIterations(t *testing.T) {
 		t.Errorf("GroupIterations.ListGroupIterations returned %+v, want %+v", iterations, want)
 	}
 }
+
+func TestListGroupIterationsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/5/iterations",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "include_ancestors=true&search=Iteration&state=opened")
+			fmt.Fprint(w, `[{"id": 53, "iid": 13, "title": "Iteration II", "state": 2}]`)
+		})
+
+	iterations, _, err := client.GroupIterations.ListGroupIterations(5, &ListGroupIterationsOptions{
+		State:            Ptr("opened"),
+		Search:           Ptr("Iteration"),
+		IncludeAncestors: Ptr(true),
+	})
+	if err != nil {
+		t.Errorf("GroupIterations.ListGroupIterations returned error: %v", err)
+	}
+
+	want := []*GroupIteration{{ID: 53, IID: 13, Title: "Iteration II", State: 2}}
+	if !reflect.DeepEqual(want, iterations) {
+		t.Errorf("GroupIterations.ListGroupIterations returned %+v, want %+v", iterations, want)
+	}
+}
@@ -46,6 +46,7 @@ type GroupMember struct {
 	Email             string                   `json:"email,omitempty"`
 	GroupSAMLIdentity *GroupMemberSAMLIdentity `json:"group_saml_identity"`
 	MemberRole        *MemberRole              `json:"member_role"`
+	MembershipState   string                   `json:"membership_state"`
 }
 
 // GroupMemberSAMLIdentity represents the SAML Identity link for the group member.
@@ -104,7 +105,8 @@ type ListGroupMembersOptions struct {
 }
 
 // ListGroupMembers get a list of group members viewable by the authenticated
-// user. Inherited members through ancestor groups are not included.
+// user. Inherited members through ancestor groups are not included, use
+// ListAllGroupMembers to include them.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project
@@ -129,8 +131,22 @@ func (s *GroupsService) ListGroupMembers(gid interface{}, opt *ListGroupMembersO
 	return gm, resp, nil
 }
 
+// CollectGroupMembers gets all pages of the list of group members viewable by
+// the authenticated user. Like ListGroupMembers, inherited members are not
+// included. Use WithMaxPages to limit the number of requested pages.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project
+func (s *GroupsService) CollectGroupMembers(gid interface{}, opt *ListGroupMembersOptions, options ...RequestOptionFunc) ([]*GroupMember, error) {
+	return Collect(func(options ...RequestOptionFunc) ([]*GroupMember, *Response, error) {
+		return s.ListGroupMembers(gid, opt, options...)
+	}, options...)
+}
+
 // ListAllGroupMembers get a list of group members viewable by the authenticated
-// user. Returns a list including inherited members through ancestor groups.
+// user. Returns a list including inherited members through ancestor groups,
+// and members of groups invited to the group or its ancestors. When a user is
+// a member through multiple paths, only the highest access level is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members
@@ -318,6 +334,17 @@ func (s *GroupMembersService) AddGroupMember(gid interface{}, opt *AddGroupMembe
 	}
 	u := fmt.Sprintf("groups/%s/members", PathEscape(group))
 
+	// Fall back to the default access level of the client (if any), without
+	// modifying the options of the caller.
+	if s.client.defaultAccessLevel != nil && (opt == nil || opt.AccessLevel == nil) {
+		o := AddGroupMemberOptions{}
+		if opt != nil {
+			o = *opt
+		}
+		o.AccessLevel = s.client.defaultAccessLevel
+		opt = &o
+	}
+
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
 	if err != nil {
 		return nil, nil, err
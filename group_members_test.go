@@ -447,3 +447,79 @@ func TestGetGroupMemberAll(t *testing.T) {
 	require.Nil(t, member)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestListAllGroupMembersIncludesInherited(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id": 1, "username": "direct", "access_level": 30, "membership_state": "active"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+			{"id": 1, "username": "direct", "access_level": 30, "membership_state": "active"},
+			{
+				"id": 2,
+				"username": "inherited",
+				"access_level": 50,
+				"membership_state": "awaiting",
+				"group_saml_identity": {"extern_uid": "ABC-1234567890", "provider": "group_saml", "saml_provider_id": 10}
+			}
+		]`)
+	})
+
+	direct, _, err := client.Groups.ListGroupMembers(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*GroupMember{
+		{ID: 1, Username: "direct", AccessLevel: DeveloperPermissions, MembershipState: "active"},
+	}, direct)
+
+	all, _, err := client.Groups.ListAllGroupMembers(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*GroupMember{
+		{ID: 1, Username: "direct", AccessLevel: DeveloperPermissions, MembershipState: "active"},
+		{
+			ID:              2,
+			Username:        "inherited",
+			AccessLevel:     OwnerPermissions,
+			MembershipState: "awaiting",
+			GroupSAMLIdentity: &GroupMemberSAMLIdentity{
+				ExternUID:      "ABC-1234567890",
+				Provider:       "group_saml",
+				SAMLProviderID: 10,
+			},
+		},
+	}, all)
+}
+
+func TestAddGroupMemberDefaultAccessLevel(t *testing.T) {
+	mux, client := setup(t)
+
+	client, err := NewClient("", WithBaseURL(client.BaseURL().String()), WithDefaultAccessLevel(DeveloperPermissions))
+	require.NoError(t, err)
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/groups/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `{"id":2,"access_level":30}`)
+	})
+
+	// The default is applied when no access level is given.
+	wantBody = `{"user_id":2,"access_level":30,"expires_at":null}`
+	opt := &AddGroupMemberOptions{UserID: Ptr(2)}
+	_, _, err = client.GroupMembers.AddGroupMember(1, opt)
+	require.NoError(t, err)
+	require.Nil(t, opt.AccessLevel, "the options of the caller must not be modified")
+
+	// An explicit access level always wins.
+	wantBody = `{"user_id":2,"access_level":40,"expires_at":null}`
+	_, _, err = client.GroupMembers.AddGroupMember(1, &AddGroupMemberOptions{
+		UserID:      Ptr(2),
+		AccessLevel: Ptr(MaintainerPermissions),
+	})
+	require.NoError(t, err)
+}
@@ -278,7 +278,7 @@ func (s *GroupMilestonesService) GetGroupMilestoneMergeRequests(gid interface{},
 	return mr, resp, nil
 }
 
-// BurndownChartEvent reprensents a burnout chart event
+// BurndownChartEvent represents a burndown chart event.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_milestones.html#get-all-burndown-chart-events-for-a-single-milestone
@@ -289,13 +289,13 @@ type BurndownChartEvent struct {
 }
 
 // GetGroupMilestoneBurndownChartEventsOptions represents the available
-// GetGroupMilestoneBurndownChartEventsOptions() options.
+// GetGroupMilestoneBurndownChartEvents() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_milestones.html#get-all-burndown-chart-events-for-a-single-milestone
 type GetGroupMilestoneBurndownChartEventsOptions ListOptions
 
-// GetGroupMilestoneBurndownChartEvents gets all merge requests assigned to a
+// GetGroupMilestoneBurndownChartEvents gets all burndown chart events for a
 // single group milestone.
 //
 // GitLab API docs:
@@ -139,3 +139,110 @@ func TestUpdateGroupVariable(t *testing.T) {
 		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", variable, want)
 	}
 }
+
+func TestDeleteGroupVariableWithEnvironmentScope(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/TEST_VARIABLE_1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			testParams(t, r, "filter%5Benvironment_scope%5D=prod")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	_, err := client.GroupVariables.RemoveVariableWithOptions(1, "TEST_VARIABLE_1", &RemoveGroupVariableOptions{
+		Filter: &VariableFilter{EnvironmentScope: "prod"},
+	})
+	if err != nil {
+		t.Errorf("GroupVariables.RemoveVariableWithOptions returned error: %v", err)
+	}
+}
+
+func TestUpdateGroupVariableWithEnvironmentScope(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/TEST_VARIABLE_1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"value":"test2","filter":{"environment_scope":"prod"}}`)
+			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value": "test2","environment_scope": "prod"}`)
+		})
+
+	variable, _, err := client.GroupVariables.UpdateVariable(1, "TEST_VARIABLE_1", &UpdateGroupVariableOptions{
+		Value:  Ptr("test2"),
+		Filter: &VariableFilter{EnvironmentScope: "prod"},
+	})
+	if err != nil {
+		t.Errorf("GroupVariables.UpdateVariable returned error: %v", err)
+	}
+
+	want := &GroupVariable{Key: "TEST_VARIABLE_1", Value: "test2", EnvironmentScope: "prod"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("GroupVariables.UpdateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestGetGroupVariableWithoutFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/TEST_VARIABLE_1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "")
+			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value": "test1"}`)
+		})
+
+	_, _, err := client.GroupVariables.GetVariable(1, "TEST_VARIABLE_1", &GetGroupVariableOptions{Filter: &VariableFilter{}})
+	if err != nil {
+		t.Errorf("GroupVariables.GetVariable returned error: %v", err)
+	}
+}
+
+func TestCreateGroupVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/variables",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, `{"key":"SECRET","value":"s3cr3t-value","masked":true,"protected":true,"raw":false,"variable_type":"file"}`)
+			fmt.Fprint(w, `{"key":"SECRET","value":"s3cr3t-value","variable_type":"file","protected":true,"masked":true,"raw":false}`)
+		})
+
+	variable, _, err := client.GroupVariables.CreateVariable(1, &CreateGroupVariableOptions{
+		Key:          Ptr("SECRET"),
+		Value:        Ptr("s3cr3t-value"),
+		Masked:       Ptr(true),
+		Protected:    Ptr(true),
+		Raw:          Ptr(false),
+		VariableType: Ptr(FileVariableType),
+	})
+	if err != nil {
+		t.Errorf("GroupVariables.CreateVariable returned error: %v", err)
+	}
+
+	want := &GroupVariable{Key: "SECRET", Value: "s3cr3t-value", VariableType: FileVariableType, Protected: true, Masked: true}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("GroupVariables.CreateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestUpdateGroupVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/variables/SECRET",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"masked":false,"protected":false,"raw":true,"variable_type":"env_var"}`)
+			fmt.Fprint(w, `{"key":"SECRET","variable_type":"env_var","raw":true}`)
+		})
+
+	_, _, err := client.GroupVariables.UpdateVariable(1, "SECRET", &UpdateGroupVariableOptions{
+		Masked:       Ptr(false),
+		Protected:    Ptr(false),
+		Raw:          Ptr(true),
+		VariableType: Ptr(EnvVariableType),
+	})
+	if err != nil {
+		t.Errorf("GroupVariables.UpdateVariable returned error: %v", err)
+	}
+}
@@ -227,7 +227,8 @@ func (s *GroupsService) ListSubGroups(gid interface{}, opt *ListSubGroupsOptions
 // https://docs.gitlab.com/ee/api/groups.html#list-a-groups-descendant-groups
 type ListDescendantGroupsOptions ListGroupsOptions
 
-// ListDescendantGroups gets a list of subgroups for a given project.
+// ListDescendantGroups gets a list of all descendant groups (subgroups at any
+// level) of a given group. Use ListSubGroups to only get the direct children.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-a-groups-descendant-groups
@@ -755,6 +756,7 @@ func (s *GroupsService) ListGroupLDAPLinks(gid interface{}, options ...RequestOp
 }
 
 // AddGroupLDAPLinkOptions represents the available AddGroupLDAPLink() options.
+// The CN and Filter options are mutually exclusive.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#add-ldap-group-link-with-cn-or-filter
@@ -766,6 +768,7 @@ type AddGroupLDAPLinkOptions struct {
 }
 
 // DeleteGroupLDAPLinkWithCNOrFilterOptions represents the available DeleteGroupLDAPLinkWithCNOrFilter() options.
+// The CN and Filter options are mutually exclusive.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link-with-cn-or-filter
@@ -402,6 +402,38 @@ func TestListGroupProjects(t *testing.T) {
 	}
 }
 
+func TestListGroupProjectsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/22/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "include_subgroups=false&min_access_level=30&starred=true"+
+				"&with_custom_attributes=true&with_issues_enabled=true&with_merge_requests_enabled=false"+
+				"&with_security_reports=true&with_shared=false")
+			fmt.Fprint(w, `[{"id":1}]`)
+		})
+
+	projects, _, err := client.Groups.ListGroupProjects(22, &ListGroupProjectsOptions{
+		IncludeSubGroups:         Ptr(false),
+		MinAccessLevel:           Ptr(DeveloperPermissions),
+		Starred:                  Ptr(true),
+		WithCustomAttributes:     Ptr(true),
+		WithIssuesEnabled:        Ptr(true),
+		WithMergeRequestsEnabled: Ptr(false),
+		WithSecurityReports:      Ptr(true),
+		WithShared:               Ptr(false),
+	})
+	if err != nil {
+		t.Errorf("Groups.ListGroupProjects returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 1}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Groups.ListGroupProjects returned %+v, want %+v", projects, want)
+	}
+}
+
 func TestListSubGroups(t *testing.T) {
 	mux, client := setup(t)
 
@@ -422,6 +454,76 @@ func TestListSubGroups(t *testing.T) {
 	}
 }
 
+func TestListDescendantGroups(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/descendant_groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "min_access_level=30&order_by=path&search=team&sort=desc")
+			fmt.Fprint(w, `[{"id": 2, "parent_id": 1}, {"id": 3, "parent_id": 2}]`)
+		})
+
+	groups, _, err := client.Groups.ListDescendantGroups(1, &ListDescendantGroupsOptions{
+		Search:         Ptr("team"),
+		OrderBy:        Ptr("path"),
+		Sort:           Ptr("desc"),
+		MinAccessLevel: Ptr(DeveloperPermissions),
+	})
+	if err != nil {
+		t.Errorf("Groups.ListDescendantGroups returned error: %v", err)
+	}
+
+	want := []*Group{{ID: 2, ParentID: 1}, {ID: 3, ParentID: 2}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Groups.ListDescendantGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestListDescendantGroupsPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	// Each page returns the next level of a deep group hierarchy.
+	pages := map[string]string{
+		"1": `[{"id": 2, "parent_id": 1}, {"id": 3, "parent_id": 2}]`,
+		"2": `[{"id": 4, "parent_id": 3}, {"id": 5, "parent_id": 4}]`,
+		"3": `[{"id": 6, "parent_id": 5}]`,
+	}
+	mux.HandleFunc("/api/v4/groups/1/descendant_groups",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			page := r.URL.Query().Get("page")
+			if page == "" {
+				page = "1"
+			}
+			if r.URL.Query().Get("per_page") != "2" {
+				t.Errorf("Request for page %s has per_page %q, want 2", page, r.URL.Query().Get("per_page"))
+			}
+			if page != "3" {
+				next := map[string]string{"1": "2", "2": "3"}[page]
+				w.Header().Set("X-Next-Page", next)
+			}
+			fmt.Fprint(w, pages[page])
+		})
+
+	opt := &ListDescendantGroupsOptions{ListOptions: ListOptions{PerPage: 2}}
+	groups, err := Collect(func(options ...RequestOptionFunc) ([]*Group, *Response, error) {
+		return client.Groups.ListDescendantGroups(1, opt, options...)
+	})
+	if err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+
+	var ids []int
+	for _, g := range groups {
+		ids = append(ids, g.ID)
+	}
+	want := []int{2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Collect returned groups %v, want %v", ids, want)
+	}
+}
+
 func TestListGroupLDAPLinks(t *testing.T) {
 	mux, client := setup(t)
 
@@ -534,6 +636,54 @@ func TestAddGroupLDAPLinkFilter(t *testing.T) {
 	}
 }
 
+func TestDeleteGroupLDAPLinkWithCNOrFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/ldap_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			testParams(t, r, "cn=gitlab_group_example_30&provider=example_ldap_provider")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	opts := &DeleteGroupLDAPLinkWithCNOrFilterOptions{
+		CN:       Ptr("gitlab_group_example_30"),
+		Provider: Ptr("example_ldap_provider"),
+	}
+
+	resp, err := client.Groups.DeleteGroupLDAPLinkWithCNOrFilter(1, opts)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroupLDAPLinkWithCNOrFilter returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Groups.DeleteGroupLDAPLinkWithCNOrFilter returned status code %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDeleteGroupLDAPLinkWithCNOrFilterFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/ldap_group_links",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			testParams(t, r, "filter=%28memberOf%3Dexample_group_dn%29&provider=example_ldap_provider")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	opts := &DeleteGroupLDAPLinkWithCNOrFilterOptions{
+		Filter:   Ptr("(memberOf=example_group_dn)"),
+		Provider: Ptr("example_ldap_provider"),
+	}
+
+	resp, err := client.Groups.DeleteGroupLDAPLinkWithCNOrFilter(1, opts)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroupLDAPLinkWithCNOrFilter returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Groups.DeleteGroupLDAPLinkWithCNOrFilter returned status code %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestListGroupSAMLLinks(t *testing.T) {
 	mux, client := setup(t)
 
@@ -59,6 +59,38 @@ func TestImportService_ImportRepositoryFromGitHub(t *testing.T) {
 	require.Nil(t, gi)
 }
 
+func TestImportService_ImportRepositoryFromGitHubPayload(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/import/github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"personal_access_token":"token","repo_id":34,"new_name":"migrated-repo",`+
+			`"target_namespace":"group/subgroup","github_hostname":"https://github.example.com",`+
+			`"optional_stages":{"single_endpoint_notes_import":false,"attachments_import":true,"collaborators_import":true},`+
+			`"timeout_strategy":"optimistic"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 28, "name": "migrated-repo", "full_path": "/group/subgroup/migrated-repo", "import_status": "scheduled"}`)
+	})
+
+	gi, _, err := client.Import.ImportRepositoryFromGitHub(&ImportRepositoryFromGitHubOptions{
+		PersonalAccessToken: Ptr("token"),
+		RepoID:              Ptr(34),
+		NewName:             Ptr("migrated-repo"),
+		TargetNamespace:     Ptr("group/subgroup"),
+		GitHubHostname:      Ptr("https://github.example.com"),
+		OptionalStages: GitHubImportOptionalStages{
+			SingleEndpointNotesImport: Ptr(false),
+			AttachmentsImport:         Ptr(true),
+			CollaboratorsImport:       Ptr(true),
+		},
+		TimeoutStrategy: Ptr("optimistic"),
+	})
+	require.NoError(t, err)
+
+	want := &GitHubImport{ID: 28, Name: "migrated-repo", FullPath: "/group/subgroup/migrated-repo", ImportStatus: "scheduled"}
+	require.Equal(t, want, gi)
+}
+
 func TestImportService_CancelGitHubProjectImport(t *testing.T) {
 	mux, client := setup(t)
 
@@ -220,3 +252,30 @@ func TestImportService_ImportRepositoryFromBitbucketCloud(t *testing.T) {
 	require.Nil(t, resp)
 	require.Nil(t, bci)
 }
+
+func TestImportService_ImportRepositoryFromBitbucketServerPayload(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/import/bitbucket_server", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"bitbucket_server_url":"https://bitbucket.example.com","bitbucket_server_username":"root",`+
+			`"personal_access_token":"token","bitbucket_server_project":"PRJ","bitbucket_server_repo":"repo",`+
+			`"new_name":"migrated-repo","new_namespace":"group/subgroup"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 29, "name": "migrated-repo", "full_path": "/group/subgroup/migrated-repo"}`)
+	})
+
+	bi, _, err := client.Import.ImportRepositoryFromBitbucketServer(&ImportRepositoryFromBitbucketServerOptions{
+		BitbucketServerUrl:      Ptr("https://bitbucket.example.com"),
+		BitbucketServerUsername: Ptr("root"),
+		PersonalAccessToken:     Ptr("token"),
+		BitbucketServerProject:  Ptr("PRJ"),
+		BitbucketServerRepo:     Ptr("repo"),
+		NewName:                 Ptr("migrated-repo"),
+		NewNamespace:            Ptr("group/subgroup"),
+	})
+	require.NoError(t, err)
+
+	want := &BitbucketServerImport{ID: 29, Name: "migrated-repo", FullPath: "/group/subgroup/migrated-repo"}
+	require.Equal(t, want, bi)
+}
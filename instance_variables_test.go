@@ -215,3 +215,51 @@ func TestInstanceVariablesService_RemoveVariable(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestInstanceVariablesService_CreateVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"key":"SECRET","value":"s3cr3t-value","masked":true,"protected":true,"raw":true,"variable_type":"file"}`)
+		fmt.Fprint(w, `{"key":"SECRET","value":"s3cr3t-value","variable_type":"file","protected":true,"masked":true,"raw":true}`)
+	})
+
+	variable, _, err := client.InstanceVariables.CreateVariable(&CreateInstanceVariableOptions{
+		Key:          Ptr("SECRET"),
+		Value:        Ptr("s3cr3t-value"),
+		Masked:       Ptr(true),
+		Protected:    Ptr(true),
+		Raw:          Ptr(true),
+		VariableType: Ptr(FileVariableType),
+	})
+	require.NoError(t, err)
+
+	want := &InstanceVariable{
+		Key:          "SECRET",
+		Value:        "s3cr3t-value",
+		VariableType: FileVariableType,
+		Protected:    true,
+		Masked:       true,
+		Raw:          true,
+	}
+	require.Equal(t, want, variable)
+}
+
+func TestInstanceVariablesService_UpdateVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/SECRET", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"masked":false,"protected":false,"raw":false,"variable_type":"env_var"}`)
+		fmt.Fprint(w, `{"key":"SECRET","variable_type":"env_var"}`)
+	})
+
+	_, _, err := client.InstanceVariables.UpdateVariable("SECRET", &UpdateInstanceVariableOptions{
+		Masked:       Ptr(false),
+		Protected:    Ptr(false),
+		Raw:          Ptr(false),
+		VariableType: Ptr(EnvVariableType),
+	})
+	require.NoError(t, err)
+}
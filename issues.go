@@ -493,6 +493,35 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 	return i, resp, nil
 }
 
+// BulkUpdateIssues applies the same update to multiple project issues. As the
+// GitLab API has no bulk edit endpoint for issues, every issue is updated with
+// an individual UpdateIssue call, using a bounded number of concurrent
+// requests.
+//
+// The returned issues are in the same order as the given issue IIDs. When one
+// or more updates fail a *BulkError keyed by issue IID is returned, and the
+// issues which could not be updated are nil. An issue IID given more than once
+// is only updated once.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issues
+func (s *IssuesService) BulkUpdateIssues(pid interface{}, issues []int, opt *UpdateIssueOptions, options ...RequestOptionFunc) ([]*Issue, error) {
+	if _, err := parseID(pid); err != nil {
+		return nil, err
+	}
+
+	updated, err := bulkDo(issues, "update issues", func(iid int) (*Issue, error) {
+		issue, _, err := s.UpdateIssue(pid, iid, opt, options...)
+		return issue, err
+	})
+
+	result := make([]*Issue, len(issues))
+	for idx, iid := range issues {
+		result[idx] = updated[iid]
+	}
+
+	return result, err
+}
+
 // DeleteIssue deletes a single project issue.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#delete-an-issue
@@ -550,8 +579,8 @@ type MoveIssueOptions struct {
 	ToProjectID *int `url:"to_project_id,omitempty" json:"to_project_id,omitempty"`
 }
 
-// MoveIssue updates an existing project issue. This function is also used
-// to mark an issue as closed.
+// MoveIssue moves an existing project issue to another project and returns
+// the issue in the target project.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#move-an-issue
 func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
@@ -575,6 +604,39 @@ func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOpti
 	return i, resp, nil
 }
 
+// CloneIssueOptions represents the available CloneIssue() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+type CloneIssueOptions struct {
+	ToProjectID *int  `url:"to_project_id,omitempty" json:"to_project_id,omitempty"`
+	WithNotes   *bool `url:"with_notes,omitempty" json:"with_notes,omitempty"`
+}
+
+// CloneIssue clones an existing project issue to another project and returns
+// the new issue in the target project.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+func (s *IssuesService) CloneIssue(pid interface{}, issue int, opt *CloneIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/clone", PathEscape(project), issue)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
 // SubscribeToIssue subscribes the authenticated user to the given issue to
 // receive notifications. If the user is already subscribed to the issue, the
 // status code 304 is returned.
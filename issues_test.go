@@ -17,11 +17,14 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -172,6 +175,49 @@ func TestMoveIssue(t *testing.T) {
 	}
 }
 
+func TestMoveIssuePostsTargetProject(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/11/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"to_project_id":5}`)
+		fmt.Fprint(w, `{"id":92,"iid":3,"project_id":5}`)
+	})
+
+	issue, _, err := client.Issues.MoveIssue(1, 11, &MoveIssueOptions{ToProjectID: Ptr(5)})
+	if err != nil {
+		t.Fatalf("Issues.MoveIssue returned error: %v", err)
+	}
+
+	want := &Issue{ID: 92, IID: 3, ProjectID: 5}
+	if !reflect.DeepEqual(want, issue) {
+		t.Errorf("Issues.MoveIssue returned %+v, want %+v", issue, want)
+	}
+}
+
+func TestCloneIssue(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/11/clone", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"to_project_id":5,"with_notes":true}`)
+		fmt.Fprint(w, `{"id":93,"iid":4,"project_id":5,"title":"Cloned issue"}`)
+	})
+
+	issue, _, err := client.Issues.CloneIssue(1, 11, &CloneIssueOptions{
+		ToProjectID: Ptr(5),
+		WithNotes:   Ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("Issues.CloneIssue returned error: %v", err)
+	}
+
+	want := &Issue{ID: 93, IID: 4, ProjectID: 5, Title: "Cloned issue"}
+	if !reflect.DeepEqual(want, issue) {
+		t.Errorf("Issues.CloneIssue returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestListIssues(t *testing.T) {
 	mux, client := setup(t)
 
@@ -652,6 +698,184 @@ func TestUpdateIssue(t *testing.T) {
 	}
 }
 
+func TestBulkUpdateIssues(t *testing.T) {
+	mux, client := setup(t)
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	for iid := 1; iid <= 12; iid++ {
+		iid := iid
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/issues/%d", iid), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"add_labels":"triaged","remove_labels":"needs-triage","state_event":"close"}`)
+
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			fmt.Fprintf(w, `{"id":%d,"iid":%d,"state":"closed"}`, 100+iid, iid)
+		})
+	}
+
+	iids := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	issues, err := client.Issues.BulkUpdateIssues(1, iids, &UpdateIssueOptions{
+		AddLabels:    &LabelOptions{"triaged"},
+		RemoveLabels: &LabelOptions{"needs-triage"},
+		StateEvent:   Ptr("close"),
+	})
+	if err != nil {
+		t.Fatalf("Issues.BulkUpdateIssues returned error: %v", err)
+	}
+
+	if len(issues) != len(iids) {
+		t.Fatalf("Issues.BulkUpdateIssues returned %d issues, want %d", len(issues), len(iids))
+	}
+	for i, issue := range issues {
+		assert.Equal(t, iids[i], issue.IID)
+		assert.Equal(t, "closed", issue.State)
+	}
+	assert.LessOrEqual(t, maxInFlight, bulkWorkers)
+}
+
+func TestBulkUpdateIssuesPartialFailure(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":101,"iid":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Not found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":103,"iid":3}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	issues, err := client.Issues.BulkUpdateIssues(1, []int{1, 2, 3, 4}, &UpdateIssueOptions{
+		MilestoneID: Ptr(7),
+		AssigneeIDs: &[]int{1, 2},
+	})
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("Issues.BulkUpdateIssues returned error %v, want *BulkError", err)
+	}
+	assert.Len(t, bulkErr.Errors, 2)
+	assert.Contains(t, bulkErr.Errors, 2)
+	assert.Contains(t, bulkErr.Errors, 4)
+	assert.Len(t, bulkErr.Unwrap(), 2)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	var errResp *ErrorResponse
+	if !errors.As(bulkErr.Errors[4], &errResp) {
+		t.Fatalf("Issues.BulkUpdateIssues error %v is not an *ErrorResponse", bulkErr.Errors[4])
+	}
+	assert.Equal(t, http.StatusForbidden, errResp.Response.StatusCode)
+
+	assert.Len(t, issues, 4)
+	assert.Equal(t, 1, issues[0].IID)
+	assert.Nil(t, issues[1])
+	assert.Equal(t, 3, issues[2].IID)
+	assert.Nil(t, issues[3])
+}
+
+func TestBulkUpdateIssuesDuplicateIIDs(t *testing.T) {
+	mux, client := setup(t)
+
+	var mu sync.Mutex
+	updates := make(map[int]int)
+	mux.HandleFunc("/api/v4/projects/1/issues/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var iid int
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/v4/projects/1/issues/%d", &iid); err != nil {
+			t.Fatalf("unexpected path %q", r.URL.Path)
+		}
+
+		mu.Lock()
+		updates[iid]++
+		mu.Unlock()
+
+		if iid == 2 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"404 Not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%d,"iid":%d}`, 100+iid, iid)
+	})
+
+	issues, err := client.Issues.BulkUpdateIssues(1, []int{1, 2, 1, 2}, &UpdateIssueOptions{
+		StateEvent: Ptr("close"),
+	})
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("Issues.BulkUpdateIssues returned error %v, want *BulkError", err)
+	}
+	assert.Len(t, bulkErr.Unwrap(), 1)
+	assert.EqualError(t, err, "failed to update issues (1): 2: "+bulkErr.Errors[2].Error())
+
+	// Every issue is only updated once.
+	assert.Equal(t, map[int]int{1: 1, 2: 1}, updates)
+
+	assert.Len(t, issues, 4)
+	assert.Equal(t, 1, issues[0].IID)
+	assert.Nil(t, issues[1])
+	assert.Equal(t, 1, issues[2].IID)
+	assert.Nil(t, issues[3])
+}
+
+func TestIssueDueDateIsDateOnly(t *testing.T) {
+	mux, client := setup(t)
+
+	// Use a time with a time component to make sure only the date is sent.
+	dueDate := ISOTime(time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC))
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"title":"Title of issue","due_date":"2024-04-30"}`)
+		fmt.Fprint(w, `{"id":1,"iid":5,"title":"Title of issue","due_date":"2024-04-30"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"due_date":"2024-04-30"}`)
+		fmt.Fprint(w, `{"id":1,"iid":5,"title":"Title of issue","due_date":"2024-04-30"}`)
+	})
+
+	issue, _, err := client.Issues.CreateIssue(1, &CreateIssueOptions{
+		Title:   Ptr("Title of issue"),
+		DueDate: &dueDate,
+	})
+	if err != nil {
+		t.Fatalf("Issues.CreateIssue returned error: %v", err)
+	}
+	assert.Equal(t, "2024-04-30", issue.DueDate.String())
+
+	_, _, err = client.Issues.UpdateIssue(1, 5, &UpdateIssueOptions{DueDate: &dueDate})
+	if err != nil {
+		t.Fatalf("Issues.UpdateIssue returned error: %v", err)
+	}
+}
+
 func TestSubscribeToIssue(t *testing.T) {
 	mux, client := setup(t)
 
@@ -752,7 +976,29 @@ func TestListMergeRequestsRelatedToIssue(t *testing.T) {
 	want := []*MergeRequest{{ID: 1, Title: "test merge one"}, {ID: 2, Title: "test merge two"}}
 
 	if !reflect.DeepEqual(want, mergeRequest) {
-		t.Errorf("Issues.ListMergeRequestsClosingIssue returned %+v, want %+v", mergeRequest, want)
+		t.Errorf("Issues.ListMergeRequestsRelatedToIssue returned %+v, want %+v", mergeRequest, want)
+	}
+}
+
+func TestListMergeRequestsRelatedToIssueNamespacedProject(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/group/project/issues/5/related_merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/group%2Fproject/issues/5/related_merge_requests")
+
+		fmt.Fprint(w, `[{"id":1,"iid":3,"project_id":1,"state":"opened","title":"test merge one"}]`)
+	})
+
+	mergeRequests, _, err := client.Issues.ListMergeRequestsRelatedToIssue("group/project", 5, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListMergeRequestsRelatedToIssue returned error: %v", err)
+	}
+
+	want := []*MergeRequest{{ID: 1, IID: 3, ProjectID: 1, State: "opened", Title: "test merge one"}}
+
+	if !reflect.DeepEqual(want, mergeRequests) {
+		t.Errorf("Issues.ListMergeRequestsRelatedToIssue returned %+v, want %+v", mergeRequests, want)
 	}
 }
 
@@ -18,7 +18,9 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 )
@@ -172,6 +174,18 @@ func (s *JobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *Li
 	return jobs, resp, nil
 }
 
+// CollectPipelineJobs gets all pages of the list of jobs for a specific
+// pipeline in a project. Use WithMaxPages to limit the number of requested
+// pages.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
+func (s *JobsService) CollectPipelineJobs(pid interface{}, pipelineID int, opts *ListJobsOptions, options ...RequestOptionFunc) ([]*Job, error) {
+	return Collect(func(options ...RequestOptionFunc) ([]*Job, *Response, error) {
+		return s.ListPipelineJobs(pid, pipelineID, opts, options...)
+	}, options...)
+}
+
 // ListPipelineBridges gets a list of bridges for specific pipeline in a
 // project.
 //
@@ -273,6 +287,28 @@ func (s *JobsService) GetJobArtifacts(pid interface{}, jobID int, options ...Req
 	return bytes.NewReader(artifactsBuf.Bytes()), resp, err
 }
 
+// StreamJobArtifacts streams the job artifacts of a project. Unlike
+// GetJobArtifacts the artifacts are not buffered in memory. Instead the
+// response body is returned as is and the caller is responsible for closing
+// it once done reading.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#get-job-artifacts
+func (s *JobsService) StreamJobArtifacts(pid interface{}, jobID int, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", PathEscape(project), jobID)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.doStream(req)
+}
+
 // DownloadArtifactsFileOptions represents the available DownloadArtifactsFile()
 // options.
 //
@@ -308,6 +344,29 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 	return bytes.NewReader(artifactsBuf.Bytes()), resp, err
 }
 
+// StreamArtifactsFile streams the artifacts file from the given reference
+// name and job provided the job finished successfully. Unlike
+// DownloadArtifactsFile the artifacts are not buffered in memory. Instead the
+// response body is returned as is and the caller is responsible for closing
+// it once done reading.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#download-the-artifacts-archive
+func (s *JobsService) StreamArtifactsFile(pid interface{}, refName string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", PathEscape(project), refName)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.doStream(req)
+}
+
 // DownloadSingleArtifactsFile download a file from the artifacts from the
 // given reference name and job provided the job finished successfully.
 // Only a single file is going to be extracted from the archive and streamed
@@ -401,6 +460,171 @@ func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...Reques
 	return bytes.NewReader(traceBuf.Bytes()), resp, err
 }
 
+// JobTraceChunk represents a part of a job trace returned by TailJobTrace.
+// When an error occurred, Err is set and no more chunks are sent.
+type JobTraceChunk struct {
+	Data []byte
+	Err  error
+}
+
+// TailJobTraceOptions represents the available TailJobTrace() options.
+type TailJobTraceOptions struct {
+	// PollInterval is the interval between polls while new output arrives.
+	// Defaults to 1 second.
+	PollInterval time.Duration
+
+	// MaxPollInterval is the maximum interval between polls. While no new
+	// output arrives the interval is doubled up to this value. Defaults to
+	// 30 seconds.
+	MaxPollInterval time.Duration
+}
+
+// TailJobTrace follows the trace (log) of a job and sends all new output on
+// the returned channel, until the job is finished. The trace is polled using
+// the Range header, so only new output is requested with every poll.
+//
+// The channel is closed once the job is finished and its complete trace has
+// been sent, when an error occurred, or when the context of the request is
+// done. Use WithContext to stop following the trace early. Callers that stop
+// reading from the channel before it is closed must cancel that context, as
+// otherwise the goroutine following the trace is never released.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/jobs.html#get-a-log-file
+func (s *JobsService) TailJobTrace(pid interface{}, jobID int, opt *TailJobTraceOptions, options ...RequestOptionFunc) (<-chan JobTraceChunk, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/%d/trace", PathEscape(project), jobID)
+
+	// Create a first request to validate the options and to get the context
+	// used to stop following the trace.
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	ctx := req.Context()
+
+	pollInterval, maxPollInterval := time.Second, 30*time.Second
+	if opt != nil && opt.PollInterval > 0 {
+		pollInterval = opt.PollInterval
+	}
+	if opt != nil && opt.MaxPollInterval > 0 {
+		maxPollInterval = opt.MaxPollInterval
+	}
+
+	ch := make(chan JobTraceChunk)
+
+	go func() {
+		defer close(ch)
+
+		send := func(chunk JobTraceChunk) bool {
+			select {
+			case ch <- chunk:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var offset int64
+		interval := pollInterval
+
+		for {
+			// Get the status before the trace, so the complete trace is
+			// requested once the job is finished.
+			job, _, err := s.GetJob(pid, jobID, options...)
+			if err != nil {
+				if ctx.Err() == nil {
+					send(JobTraceChunk{Err: err})
+				}
+				return
+			}
+			finished := !isActiveBuildState(BuildStateValue(job.Status))
+
+			data, err := s.getTraceFrom(u, offset, options)
+			if err != nil {
+				if ctx.Err() == nil {
+					send(JobTraceChunk{Err: err})
+				}
+				return
+			}
+
+			if len(data) > 0 {
+				if !send(JobTraceChunk{Data: data}) {
+					return
+				}
+				offset += int64(len(data))
+				interval = pollInterval
+			} else {
+				interval *= 2
+				if interval > maxPollInterval {
+					interval = maxPollInterval
+				}
+			}
+
+			if finished {
+				return
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// getTraceFrom returns the part of the job trace at u starting at offset.
+func (s *JobsService) getTraceFrom(u string, offset int64, options []RequestOptionFunc) ([]byte, error) {
+	if offset > 0 {
+		options = append(options[:len(options):len(options)], WithHeader("Range", fmt.Sprintf("bytes=%d-", offset)))
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace bytes.Buffer
+	resp, err := s.client.Do(req, &trace)
+	if err != nil {
+		// No new output since the last request.
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data := trace.Bytes()
+
+	// The Range header is ignored when the complete trace is returned.
+	if resp.StatusCode != http.StatusPartialContent && offset > 0 {
+		if offset > int64(len(data)) {
+			offset = int64(len(data))
+		}
+		data = data[offset:]
+	}
+
+	return data, nil
+}
+
+// isActiveBuildState reports whether a job with the given state is not yet
+// finished.
+func isActiveBuildState(state BuildStateValue) bool {
+	switch state {
+	case Created, WaitingForResource, Preparing, Pending, Running, Scheduled, Canceling:
+		return true
+	default:
+		return false
+	}
+}
+
 // CancelJob cancels a single job of a project.
 //
 // GitLab API docs:
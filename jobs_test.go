@@ -17,13 +17,20 @@
 package gitlab
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListPipelineJobs(t *testing.T) {
@@ -218,3 +225,269 @@ func TestDownloadSingleArtifactsFileByTagOrBranch(t *testing.T) {
 		t.Errorf("Jobs.DownloadSingleArtifactsFileByTagOrBranch returned returned status code  %+v, want %+v", resp.StatusCode, wantCode)
 	}
 }
+
+func TestStreamJobArtifacts(t *testing.T) {
+	mux, client := setup(t)
+
+	chunk := bytes.Repeat([]byte("a"), 1024)
+	consumed := make(chan struct{})
+
+	mux.HandleFunc("/api/v4/projects/9/jobs/1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusOK)
+
+		// Send the first chunk and wait until it's consumed by the client before
+		// sending the rest. This only works if the body isn't buffered first.
+		w.Write(chunk)
+		w.(http.Flusher).Flush()
+		<-consumed
+
+		for i := 0; i < 9; i++ {
+			w.Write(chunk)
+		}
+	})
+
+	body, resp, err := client.Jobs.StreamJobArtifacts(9, 1)
+	if err != nil {
+		t.Fatalf("Jobs.StreamJobArtifacts returns an error: %v", err)
+	}
+	defer body.Close()
+
+	first := make([]byte, len(chunk))
+	if _, err := io.ReadFull(body, first); err != nil {
+		t.Fatalf("Jobs.StreamJobArtifacts error reading first chunk: %v", err)
+	}
+	close(consumed)
+
+	rest, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("Jobs.StreamJobArtifacts error reading: %v", err)
+	}
+
+	assert.Equal(t, chunk, first)
+	assert.Len(t, rest, 9*len(chunk))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestStreamArtifactsFile(t *testing.T) {
+	mux, client := setup(t)
+
+	wantContent := []byte("This is the archive content")
+	mux.HandleFunc("/api/v4/projects/9/jobs/artifacts/abranch/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "job=publish")
+		w.WriteHeader(http.StatusOK)
+		w.Write(wantContent)
+	})
+
+	opt := &DownloadArtifactsFileOptions{Job: Ptr("publish")}
+	body, _, err := client.Jobs.StreamArtifactsFile(9, "abranch", opt, WithRequestTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("Jobs.StreamArtifactsFile returns an error: %v", err)
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("Jobs.StreamArtifactsFile error reading: %v", err)
+	}
+	assert.Equal(t, wantContent, content)
+}
+
+func TestStreamArtifactsFileNotFound(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/9/jobs/artifacts/abranch/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	body, resp, err := client.Jobs.StreamArtifactsFile(9, "abranch", nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, body)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+// growingTrace simulates the trace of a running job, which grows with every
+// poll until the job is finished.
+type growingTrace struct {
+	mu          sync.Mutex
+	parts       []string
+	polls       int
+	trace       string
+	ranges      []string
+	ignoreRange bool
+}
+
+func (g *growingTrace) handleJob(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Every poll of the job status adds the next part of the trace.
+	status := "running"
+	if g.polls < len(g.parts) {
+		g.trace += g.parts[g.polls]
+	}
+	g.polls++
+	if g.polls >= len(g.parts) {
+		status = "success"
+	}
+	fmt.Fprintf(w, `{"id":2,"status":%q}`, status)
+}
+
+func (g *growingTrace) handleTrace(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	rg := r.Header.Get("Range")
+	g.ranges = append(g.ranges, rg)
+
+	if rg == "" || g.ignoreRange {
+		fmt.Fprint(w, g.trace)
+		return
+	}
+
+	offset, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rg, "bytes="), "-"))
+	if err != nil || offset >= len(g.trace) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	w.WriteHeader(http.StatusPartialContent)
+	fmt.Fprint(w, g.trace[offset:])
+}
+
+func TestTailJobTrace(t *testing.T) {
+	for _, ignoreRange := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ignore range %t", ignoreRange), func(t *testing.T) {
+			mux, client := setup(t)
+
+			g := &growingTrace{
+				parts:       []string{"line 1\n", "line 2\n", "", "line 3\n"},
+				ignoreRange: ignoreRange,
+			}
+			mux.HandleFunc("/api/v4/projects/1/jobs/2", g.handleJob)
+			mux.HandleFunc("/api/v4/projects/1/jobs/2/trace", g.handleTrace)
+
+			ch, err := client.Jobs.TailJobTrace(1, 2, &TailJobTraceOptions{
+				PollInterval:    time.Millisecond,
+				MaxPollInterval: 5 * time.Millisecond,
+			})
+			require.NoError(t, err)
+
+			var chunks []string
+			for chunk := range ch {
+				require.NoError(t, chunk.Err)
+				chunks = append(chunks, string(chunk.Data))
+			}
+
+			assert.Equal(t, []string{"line 1\n", "line 2\n", "line 3\n"}, chunks)
+			assert.Equal(t, []string{"", "bytes=7-", "bytes=14-", "bytes=14-"}, g.ranges)
+		})
+	}
+}
+
+func TestTailJobTraceError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":2,"status":"running"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/jobs/2/trace", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	ch, err := client.Jobs.TailJobTrace(1, 2, nil)
+	require.NoError(t, err)
+
+	chunk, ok := <-ch
+	require.True(t, ok)
+	assert.True(t, IsForbidden(chunk.Err))
+
+	_, ok = <-ch
+	assert.False(t, ok, "the channel must be closed after an error")
+}
+
+func TestTailJobTraceCanceled(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":2,"status":"running"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/jobs/2/trace", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		fmt.Fprint(w, "line 1\n")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := client.Jobs.TailJobTrace(1, 2, &TailJobTraceOptions{PollInterval: time.Millisecond}, WithContext(ctx))
+	require.NoError(t, err)
+
+	chunk := <-ch
+	require.NoError(t, chunk.Err)
+	assert.Equal(t, "line 1\n", string(chunk.Data))
+
+	cancel()
+	for chunk := range ch {
+		assert.NoError(t, chunk.Err)
+	}
+}
+
+func TestTailJobTraceCanceling(t *testing.T) {
+	mux, client := setup(t)
+
+	var polls int
+	mux.HandleFunc("/api/v4/projects/1/jobs/2", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"id":2,"status":"canceling"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":2,"status":"canceled"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/jobs/2/trace", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		fmt.Fprint(w, "line 1\n")
+	})
+
+	ch, err := client.Jobs.TailJobTrace(1, 2, &TailJobTraceOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+
+	for chunk := range ch {
+		require.NoError(t, chunk.Err)
+	}
+
+	// A canceling job is not finished yet, so it is polled again.
+	assert.Equal(t, 2, polls)
+}
+
+func TestPlayJob(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/42/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"job_variables_attributes":[{"key":"DEPLOY_ENV","value":"staging"},{"key":"CONFIG","value":"debug: true","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id": 42, "name": "deploy", "status": "pending"}`)
+	})
+
+	job, _, err := client.Jobs.PlayJob(1, 42, &PlayJobOptions{
+		JobVariablesAttributes: &[]*JobVariableOptions{
+			{Key: Ptr("DEPLOY_ENV"), Value: Ptr("staging")},
+			{Key: Ptr("CONFIG"), Value: Ptr("debug: true"), VariableType: Ptr(FileVariableType)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Jobs.PlayJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 42, Name: "deploy", Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.PlayJob returned %+v, want %+v", job, want)
+	}
+}
@@ -147,7 +147,7 @@ func TestGetKeyByFingerprint(t *testing.T) {
 		Fingerprint: "07:51:20:af:17:e4:a8:ab:22:79:9b:31:ae:a9:61:f3",
 	})
 	if err != nil {
-		t.Errorf("Keys.GetKeyWithUserByFingerprint returned error: %v", err)
+		t.Errorf("Keys.GetKeyByFingerprint returned error: %v", err)
 	}
 
 	want := &Key{
@@ -181,6 +181,52 @@ func TestGetKeyByFingerprint(t *testing.T) {
 	}
 
 	if !reflect.DeepEqual(want, key) {
-		t.Errorf("Keys.GetKeyWithUserByFingerprint returned %+v, want %+v", key, want)
+		t.Errorf("Keys.GetKeyByFingerprint returned %+v, want %+v", key, want)
+	}
+}
+
+func TestGetKeyByFingerprintSHA256(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/keys",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "fingerprint=SHA256%3AnUhzNyftwADy8AH3wFY31tAKs7HufskYTte2aXo%2FlCg")
+			fmt.Fprint(w, `{"id": 2, "title": "Deploy key", "key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJe", "user": {"id": 1, "username": "root"}}`)
+		})
+
+	key, _, err := client.Keys.GetKeyByFingerprint(&GetKeyByFingerprintOptions{
+		Fingerprint: "SHA256:nUhzNyftwADy8AH3wFY31tAKs7HufskYTte2aXo/lCg",
+	})
+	if err != nil {
+		t.Fatalf("Keys.GetKeyByFingerprint returned error: %v", err)
+	}
+
+	want := &Key{
+		ID:    2,
+		Title: "Deploy key",
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJe",
+		User:  User{ID: 1, Username: "root"},
+	}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Keys.GetKeyByFingerprint returned %+v, want %+v", key, want)
+	}
+}
+
+func TestGetKeyByFingerprintNotFound(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/keys",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "404 Not found"}`)
+		})
+
+	_, _, err := client.Keys.GetKeyByFingerprint(&GetKeyByFingerprintOptions{
+		Fingerprint: "07:51:20:af:17:e4:a8:ab:22:79:9b:31:ae:a9:61:f3",
+	})
+	if !IsNotFound(err) {
+		t.Errorf("Keys.GetKeyByFingerprint returned error %v, want a not found error", err)
 	}
 }
@@ -301,25 +301,42 @@ func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, lid interface{}, o
 	return s.client.Do(req, nil)
 }
 
-// PromoteLabel Promotes a project label to a group label.
+// PromoteLabel Promotes a project label to a group label. Use
+// PromoteLabelToGroup to also get the resulting group label.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/labels.html#promote-a-project-label-to-a-group-label
 func (s *LabelsService) PromoteLabel(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	_, resp, err := s.PromoteLabelToGroup(pid, lid, options...)
+	return resp, err
+}
+
+// PromoteLabelToGroup promotes a project label to a group label like
+// PromoteLabel, and returns the resulting group label.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/labels.html#promote-a-project-label-to-a-group-label
+func (s *LabelsService) PromoteLabelToGroup(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	label, err := parseID(lid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/labels/%s/promote", PathEscape(project), PathEscape(label))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, nil, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	gl := new(GroupLabel)
+	resp, err := s.client.Do(req, gl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gl, resp, nil
 }
@@ -184,3 +184,36 @@ func TestGetLabel(t *testing.T) {
 		t.Errorf("Labels.GetLabel returned %+v, want %+v", label, want)
 	}
 }
+
+func TestPromoteLabel(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/labels/MyLabel/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": 5, "name": "MyLabel", "color": "#11FF22", "is_project_label": false}`)
+	})
+
+	_, err := client.Labels.PromoteLabel("1", "MyLabel")
+	if err != nil {
+		t.Fatalf("Labels.PromoteLabel returned error: %v", err)
+	}
+}
+
+func TestPromoteLabelToGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/labels/MyLabel/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": 5, "name": "MyLabel", "color": "#11FF22", "is_project_label": false}`)
+	})
+
+	label, _, err := client.Labels.PromoteLabelToGroup("1", "MyLabel")
+	if err != nil {
+		t.Fatalf("Labels.PromoteLabelToGroup returned error: %v", err)
+	}
+
+	want := &GroupLabel{ID: 5, Name: "MyLabel", Color: "#11FF22", IsProjectLabel: false}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("Labels.PromoteLabelToGroup returned %+v, want %+v", label, want)
+	}
+}
@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 )
@@ -38,3 +39,27 @@ func TestRender(t *testing.T) {
 			markdownHTMLResponse, markdown.HTML)
 	}
 }
+
+func TestRenderRequestBody(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"text":"Hello @user, see #1","gfm":true,"project":"group/project"}`)
+		fmt.Fprint(w, `{"html":"<p data-sourcepos=\"1:1-1:20\" dir=\"auto\">Hello @user, see #1</p>"}`)
+	})
+
+	markdown, _, err := client.Markdown.Render(&RenderOptions{
+		Text:                    Ptr("Hello @user, see #1"),
+		GitlabFlavouredMarkdown: Ptr(true),
+		Project:                 Ptr("group/project"),
+	})
+	if err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+
+	want := `<p data-sourcepos="1:1-1:20" dir="auto">Hello @user, see #1</p>`
+	if markdown.HTML != want {
+		t.Errorf("Render returned wrong response, expected %q but got %q", want, markdown.HTML)
+	}
+}
@@ -432,3 +432,81 @@ func TestCreateApprovalRules(t *testing.T) {
 		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestCreateApprovalRuleFromProjectRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"security","approvals_required":2,"approval_project_rule_id":7,"user_ids":[5],"group_ids":[9]}`)
+		fmt.Fprint(w, `{"id": 2, "name": "security", "rule_type": "regular", "approvals_required": 2}`)
+	})
+
+	rule, _, err := client.MergeRequestApprovals.CreateApprovalRule(1, 1, &CreateMergeRequestApprovalRuleOptions{
+		Name:                  Ptr("security"),
+		ApprovalsRequired:     Ptr(2),
+		ApprovalProjectRuleID: Ptr(7),
+		UserIDs:               &[]int{5},
+		GroupIDs:              &[]int{9},
+	})
+	if err != nil {
+		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovalRule{ID: 2, Name: "security", RuleType: "regular", ApprovalsRequired: 2}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestUpdateApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"name":"optional review","approvals_required":0,"user_ids":[]}`)
+		fmt.Fprint(w, `{"id": 2, "name": "optional review", "rule_type": "regular", "approvals_required": 0}`)
+	})
+
+	rule, _, err := client.MergeRequestApprovals.UpdateApprovalRule(1, 1, 2, &UpdateMergeRequestApprovalRuleOptions{
+		Name:              Ptr("optional review"),
+		ApprovalsRequired: Ptr(0),
+		UserIDs:           &[]int{},
+	})
+	if err != nil {
+		t.Errorf("MergeRequestApprovals.UpdateApprovalRule returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovalRule{ID: 2, Name: "optional review", RuleType: "regular"}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("MergeRequestApprovals.UpdateApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestDeleteApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.MergeRequestApprovals.DeleteApprovalRule(1, 1, 2)
+	if err != nil {
+		t.Errorf("MergeRequestApprovals.DeleteApprovalRule returned error: %v", err)
+	}
+}
+
+func TestResetApprovalsOfMergeRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/reset_approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := client.MergeRequestApprovals.ResetApprovalsOfMergeRequest(1, 1)
+	if err != nil {
+		t.Errorf("MergeRequestApprovals.ResetApprovalsOfMergeRequest returned error: %v", err)
+	}
+}
@@ -801,6 +801,7 @@ type AcceptMergeRequestOptions struct {
 	Squash                    *bool   `url:"squash,omitempty" json:"squash,omitempty"`
 	ShouldRemoveSourceBranch  *bool   `url:"should_remove_source_branch,omitempty" json:"should_remove_source_branch,omitempty"`
 	MergeWhenPipelineSucceeds *bool   `url:"merge_when_pipeline_succeeds,omitempty" json:"merge_when_pipeline_succeeds,omitempty"`
+	AutoMerge                 *bool   `url:"auto_merge,omitempty" json:"auto_merge,omitempty"`
 	SHA                       *string `url:"sha,omitempty" json:"sha,omitempty"`
 }
 
@@ -330,7 +330,46 @@ func TestCreateMergeRequestPipeline(t *testing.T) {
 	}
 
 	assert.Equal(t, 1, pipeline.ID)
-	assert.Equal(t, "pending", pipeline.Status)
+	assert.Equal(t, PipelineStatusPending, pipeline.Status)
+}
+
+func TestAcceptMergeRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"merge_commit_message":"Merge branch 'feature'","squash_commit_message":"Add feature","squash":true,"should_remove_source_branch":true,"merge_when_pipeline_succeeds":false,"auto_merge":true,"sha":"6104942438c14ec7bd21c6cd5bd995272b3faff6"}`)
+		fmt.Fprint(w, `{"id":1, "iid":1, "state":"merged", "squash":true}`)
+	})
+
+	mr, _, err := client.MergeRequests.AcceptMergeRequest(1, 1, &AcceptMergeRequestOptions{
+		MergeCommitMessage:        Ptr("Merge branch 'feature'"),
+		SquashCommitMessage:       Ptr("Add feature"),
+		Squash:                    Ptr(true),
+		ShouldRemoveSourceBranch:  Ptr(true),
+		MergeWhenPipelineSucceeds: Ptr(false),
+		AutoMerge:                 Ptr(true),
+		SHA:                       Ptr("6104942438c14ec7bd21c6cd5bd995272b3faff6"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "merged", mr.State)
+	assert.True(t, mr.Squash)
+}
+
+func TestAcceptMergeRequestOmitsUnsetOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"squash_commit_message":"Add feature"}`)
+		fmt.Fprint(w, `{"id":1, "iid":1, "state":"merged"}`)
+	})
+
+	_, _, err := client.MergeRequests.AcceptMergeRequest(1, 1, &AcceptMergeRequestOptions{
+		SquashCommitMessage: Ptr("Add feature"),
+	})
+	require.NoError(t, err)
 }
 
 func TestGetMergeRequestParticipants(t *testing.T) {
@@ -447,6 +486,40 @@ func TestListMergeRequestDiffs(t *testing.T) {
 	}
 }
 
+func TestListMergeRequestDiffsPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/diffs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=1&unidiff=true")
+		w.Header().Set(xTotal, "3")
+		w.Header().Set(xTotalPages, "3")
+		w.Header().Set(xPerPage, "1")
+		w.Header().Set(xPage, "2")
+		w.Header().Set(xNextPage, "3")
+		w.Header().Set(xPrevPage, "1")
+		fmt.Fprint(w, `[{"old_path":"VERSION","new_path":"VERSION","diff":"@@ -1.9.7 +1.9.8"}]`)
+	})
+
+	opts := &ListMergeRequestDiffsOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 1},
+		Unidiff:     Ptr(true),
+	}
+
+	diffs, resp, err := client.MergeRequests.ListMergeRequestDiffs(1, 1, opts)
+	require.NoError(t, err)
+
+	want := []*MergeRequestDiff{{OldPath: "VERSION", NewPath: "VERSION", Diff: "@@ -1.9.7 +1.9.8"}}
+	assert.Equal(t, want, diffs)
+
+	assert.Equal(t, 3, resp.TotalItems)
+	assert.Equal(t, 3, resp.TotalPages)
+	assert.Equal(t, 1, resp.ItemsPerPage)
+	assert.Equal(t, 2, resp.CurrentPage)
+	assert.Equal(t, 3, resp.NextPage)
+	assert.Equal(t, 1, resp.PreviousPage)
+}
+
 func TestIntSliceOrString(t *testing.T) {
 	t.Run("any", func(t *testing.T) {
 		opts := &ListMergeRequestsOptions{}
@@ -90,7 +90,7 @@ func (s *MergeTrainsService) ListMergeRequestInMergeTrain(pid interface{}, targe
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), targetBranch)
+	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), PathEscape(targetBranch))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
@@ -288,3 +289,76 @@ func TestAddMergeRequestToMergeTrain(t *testing.T) {
 		t.Errorf("MergeTrains.AddMergeRequestToMergeTrain returned %+v, want %+v", mergeTrains, want)
 	}
 }
+
+func TestListMergeRequestInMergeTrainActiveCars(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_trains/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/merge_trains/release%2F1%2E0?scope=active&sort=asc")
+		fmt.Fprint(w, `[
+			{
+				"id": 267,
+				"merge_request": {"id": 273, "iid": 1, "project_id": 597, "state": "opened"},
+				"user": {"id": 5, "username": "root", "name": "Administrator"},
+				"pipeline": {"id": 273, "sha": "af9d8b4cdf4cd1ee5ad56ee4b1b8b4c9ac1cfba8", "ref": "refs/merge-requests/1/train", "status": "success"},
+				"target_branch": "release/1.0",
+				"status": "fresh",
+				"merged_at": null,
+				"duration": 70
+			},
+			{
+				"id": 268,
+				"merge_request": {"id": 274, "iid": 2, "project_id": 597, "state": "opened"},
+				"user": {"id": 6, "username": "jdoe", "name": "John Doe"},
+				"pipeline": {"id": 274, "sha": "bcc17a8ffd51be1afe45605e714085df28b80b13", "ref": "refs/merge-requests/2/train", "status": "running"},
+				"target_branch": "release/1.0",
+				"status": "idle",
+				"merged_at": null,
+				"duration": 12
+			}
+		]`)
+	})
+
+	opts := &ListMergeTrainsOptions{Scope: Ptr("active"), Sort: Ptr("asc")}
+
+	mergeTrains, _, err := client.MergeTrains.ListMergeRequestInMergeTrain(1, "release/1.0", opts)
+	if err != nil {
+		t.Fatalf("MergeTrains.ListMergeRequestInMergeTrain returned error: %v", err)
+	}
+
+	want := []*MergeTrain{
+		{
+			ID:           267,
+			MergeRequest: &MergeTrainMergeRequest{ID: 273, IID: 1, ProjectID: 597, State: "opened"},
+			User:         &BasicUser{ID: 5, Username: "root", Name: "Administrator"},
+			Pipeline: &Pipeline{
+				ID:     273,
+				SHA:    "af9d8b4cdf4cd1ee5ad56ee4b1b8b4c9ac1cfba8",
+				Ref:    "refs/merge-requests/1/train",
+				Status: "success",
+			},
+			TargetBranch: "release/1.0",
+			Status:       "fresh",
+			Duration:     70,
+		},
+		{
+			ID:           268,
+			MergeRequest: &MergeTrainMergeRequest{ID: 274, IID: 2, ProjectID: 597, State: "opened"},
+			User:         &BasicUser{ID: 6, Username: "jdoe", Name: "John Doe"},
+			Pipeline: &Pipeline{
+				ID:     274,
+				SHA:    "bcc17a8ffd51be1afe45605e714085df28b80b13",
+				Ref:    "refs/merge-requests/2/train",
+				Status: "running",
+			},
+			TargetBranch: "release/1.0",
+			Status:       "idle",
+			Duration:     12,
+		},
+	}
+
+	if !reflect.DeepEqual(want, mergeTrains) {
+		t.Errorf("MergeTrains.ListMergeRequestInMergeTrain returned %+v, want %+v", mergeTrains, want)
+	}
+}
@@ -30,21 +30,27 @@ type MetadataService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/metadata.html
 type Metadata struct {
-	Version  string `json:"version"`
-	Revision string `json:"revision"`
-	KAS      struct {
-		Enabled     bool   `json:"enabled"`
-		ExternalURL string `json:"externalUrl"`
-		Version     string `json:"version"`
-	} `json:"kas"`
-	Enterprise bool `json:"enterprise"`
+	Version    string      `json:"version"`
+	Revision   string      `json:"revision"`
+	KAS        MetadataKAS `json:"kas"`
+	Enterprise bool        `json:"enterprise"`
+}
+
+// MetadataKAS represents the GitLab agent server (KAS) information of a
+// GitLab instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/metadata.html
+type MetadataKAS struct {
+	Enabled     bool   `json:"enabled"`
+	ExternalURL string `json:"externalUrl"`
+	Version     string `json:"version"`
 }
 
 func (s Metadata) String() string {
 	return Stringify(s)
 }
 
-// GetMetadata gets a GitLab server instance meteadata.
+// GetMetadata gets a GitLab server instance metadata.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/metadata.html
 func (s *MetadataService) GetMetadata(options ...RequestOptionFunc) (*Metadata, *Response, error) {
@@ -47,11 +47,7 @@ func TestGetMetadata(t *testing.T) {
 	}
 
 	want := &Metadata{
-		Version: "15.6.0-pre", Revision: "016e8d8bdc3", KAS: struct {
-			Enabled     bool   `json:"enabled"`
-			ExternalURL string `json:"externalUrl"`
-			Version     string `json:"version"`
-		}{
+		Version: "15.6.0-pre", Revision: "016e8d8bdc3", KAS: MetadataKAS{
 			Enabled:     true,
 			ExternalURL: "wss://kas.gitlab.com",
 			Version:     "15.6.0-rc2",
@@ -62,3 +58,37 @@ func TestGetMetadata(t *testing.T) {
 		t.Errorf("Metadata.GetMetadata returned %+v, want %+v", version, want)
 	}
 }
+
+func TestGetMetadataKASDisabled(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/metadata",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{
+        "version": "16.0.0",
+        "revision": "a1b2c3d4e5f",
+        "enterprise": false,
+        "kas": {
+          "enabled": false,
+          "externalUrl": null,
+          "version": null
+        }
+      }`)
+		})
+
+	metadata, _, err := client.Metadata.GetMetadata()
+	if err != nil {
+		t.Errorf("Metadata.GetMetadata returned error: %v", err)
+	}
+
+	want := &Metadata{
+		Version:    "16.0.0",
+		Revision:   "a1b2c3d4e5f",
+		KAS:        MetadataKAS{},
+		Enterprise: false,
+	}
+	if !reflect.DeepEqual(want, metadata) {
+		t.Errorf("Metadata.GetMetadata returned %+v, want %+v", metadata, want)
+	}
+}
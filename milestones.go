@@ -270,3 +270,36 @@ func (s *MilestonesService) GetMilestoneMergeRequests(pid interface{}, milestone
 
 	return mr, resp, nil
 }
+
+// GetMilestoneBurndownChartEventsOptions represents the available
+// GetMilestoneBurndownChartEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+type GetMilestoneBurndownChartEventsOptions ListOptions
+
+// GetMilestoneBurndownChartEvents gets all burndown chart events for a single
+// project milestone.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+func (s *MilestonesService) GetMilestoneBurndownChartEvents(pid interface{}, milestone int, opt *GetMilestoneBurndownChartEventsOptions, options ...RequestOptionFunc) ([]*BurndownChartEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/burndown_events", PathEscape(project), milestone)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var be []*BurndownChartEvent
+	resp, err := s.client.Do(req, &be)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return be, resp, nil
+}
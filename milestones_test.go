@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -210,6 +211,38 @@ func TestMilestonesService_UpdateMilestone(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestMilestonesService_MilestoneDatesAreDateOnly(t *testing.T) {
+	mux, client := setup(t)
+
+	// Use a time with a time component to make sure only the date is sent.
+	startDate := ISOTime(time.Date(2024, 4, 1, 13, 37, 42, 0, time.UTC))
+	dueDate := ISOTime(time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC))
+
+	mux.HandleFunc("/api/v4/projects/5/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"title":"v1.0","start_date":"2024-04-01","due_date":"2024-04-30"}`)
+		fmt.Fprint(w, `{"id":12,"iid":3,"title":"v1.0","start_date":"2024-04-01","due_date":"2024-04-30"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/5/milestones/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"start_date":"2024-04-01","due_date":"2024-04-30"}`)
+		fmt.Fprint(w, `{"id":12,"iid":3,"title":"v1.0","start_date":"2024-04-01","due_date":"2024-04-30"}`)
+	})
+
+	_, _, err := client.Milestones.CreateMilestone(5, &CreateMilestoneOptions{
+		Title:     Ptr("v1.0"),
+		StartDate: &startDate,
+		DueDate:   &dueDate,
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.Milestones.UpdateMilestone(5, 12, &UpdateMilestoneOptions{
+		StartDate: &startDate,
+		DueDate:   &dueDate,
+	})
+	require.NoError(t, err)
+}
+
 func TestMilestonesService_DeleteMilestone(t *testing.T) {
 	mux, client := setup(t)
 
@@ -568,3 +601,52 @@ func TestMilestonesService_GetMilestoneMergeRequests(t *testing.T) {
 	require.Nil(t, mrs)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestMilestonesService_GetMilestoneBurndownChartEvents(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/milestones/12/burndown_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+			[
+				{
+					"created_at": "2024-01-01T10:00:00Z",
+					"weight": 3,
+					"action": "add"
+				},
+				{
+					"created_at": "2024-01-02T10:00:00Z",
+					"weight": 3,
+					"action": "remove"
+				}
+			]
+		`)
+	})
+
+	added := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	removed := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+	want := []*BurndownChartEvent{
+		{CreatedAt: &added, Weight: Ptr(3), Action: Ptr("add")},
+		{CreatedAt: &removed, Weight: Ptr(3), Action: Ptr("remove")},
+	}
+
+	bces, resp, err := client.Milestones.GetMilestoneBurndownChartEvents(1, 12, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, bces)
+
+	bces, resp, err = client.Milestones.GetMilestoneBurndownChartEvents(1.01, 12, nil, nil)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, bces)
+
+	bces, resp, err = client.Milestones.GetMilestoneBurndownChartEvents(1, 12, nil, nil, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, bces)
+
+	bces, resp, err = client.Milestones.GetMilestoneBurndownChartEvents(3, 12, nil, nil)
+	require.Error(t, err)
+	require.Nil(t, bces)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -23,6 +23,31 @@ import (
 	"testing"
 )
 
+func TestListIssueNotesSorted(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4329/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "order_by=updated_at&page=1&per_page=50&sort=asc")
+		fmt.Fprint(w, `[{"id":1,"body":"first"},{"id":2,"body":"second"}]`)
+	})
+
+	opt := &ListIssueNotesOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 50},
+		OrderBy:     Ptr("updated_at"),
+		Sort:        Ptr("asc"),
+	}
+	notes, _, err := client.Notes.ListIssueNotes(1, 4329, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Note{{ID: 1, Body: "first"}, {ID: 2, Body: "second"}}
+	if !reflect.DeepEqual(want, notes) {
+		t.Errorf("Notes.ListIssueNotes returned %+v, want %+v", notes, want)
+	}
+}
+
 func TestGetEpicNote(t *testing.T) {
 	mux, client := setup(t)
 
@@ -36,14 +36,22 @@ type Pages struct {
 	Deployments           []*PagesDeployment `json:"deployments"`
 }
 
-// PagesDeployment represents a Pages deployment.
+// PagesDeployment represents a Pages deployment. Projects using parallel
+// deployments have one active deployment per path prefix. The ID, Active,
+// Size, FileCount and ExpiresAt fields are only set by ListPagesDeployments
+// and GetPagesDeployment.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pages.html
 type PagesDeployment struct {
-	CreatedAt     time.Time `json:"created_at"`
-	URL           string    `json:"url"`
-	PathPrefix    string    `json:"path_prefix"`
-	RootDirectory string    `json:"root_directory"`
+	ID            int        `json:"id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	URL           string     `json:"url"`
+	PathPrefix    string     `json:"path_prefix"`
+	RootDirectory string     `json:"root_directory"`
+	Active        bool       `json:"active"`
+	Size          int        `json:"size"`
+	FileCount     int        `json:"file_count"`
+	ExpiresAt     *time.Time `json:"expires_at"`
 }
 
 // UnpublishPages unpublished pages. The user must have admin privileges.
@@ -65,8 +73,8 @@ func (s *PagesService) UnpublishPages(gid interface{}, options ...RequestOptionF
 	return s.client.Do(req, nil)
 }
 
-// GetPages lists Pages settings for a project. The user must have at least
-// maintainer privileges.
+// GetPages lists Pages settings for a project, including its active
+// deployments. The user must have at least maintainer privileges.
 //
 // GitLab API Docs:
 // https://docs.gitlab.com/ee/api/pages.html#get-pages-settings-for-a-project
@@ -125,3 +133,64 @@ func (s *PagesService) UpdatePages(pid interface{}, opt UpdatePagesOptions, opti
 
 	return p, resp, nil
 }
+
+// ListPagesDeploymentsOptions represents the available ListPagesDeployments()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pages.html#list-pages-deployments
+type ListPagesDeploymentsOptions struct {
+	ListOptions
+	Active *bool   `url:"active,omitempty" json:"active,omitempty"`
+	Sort   *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListPagesDeployments gets a list of the Pages deployments of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pages.html#list-pages-deployments
+func (s *PagesService) ListPagesDeployments(pid interface{}, opt *ListPagesDeploymentsOptions, options ...RequestOptionFunc) ([]*PagesDeployment, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pages/deployments", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ds []*PagesDeployment
+	resp, err := s.client.Do(req, &ds)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ds, resp, nil
+}
+
+// GetPagesDeployment gets a single Pages deployment of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pages.html#get-a-pages-deployment
+func (s *PagesService) GetPagesDeployment(pid interface{}, deployment int, options ...RequestOptionFunc) (*PagesDeployment, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pages/deployments/%d", PathEscape(project), deployment)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(PagesDeployment)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
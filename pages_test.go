@@ -79,6 +79,59 @@ func TestGetPages(t *testing.T) {
 	require.Equal(t, want, p)
 }
 
+func TestGetPagesParallelDeployments(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/projects/group/project/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/group%2Fproject/pages")
+		fmt.Fprint(w, `
+		  {
+			"url": "https://group.example.io/project",
+			"deployments": [
+			  {
+				"created_at": "2024-05-01T10:00:00Z",
+				"url": "https://group.example.io/project/",
+				"path_prefix": "",
+				"root_directory": "public"
+			  },
+			  {
+				"created_at": "2024-05-02T10:00:00Z",
+				"url": "https://group.example.io/project/mr-42/",
+				"path_prefix": "mr-42",
+				"root_directory": "public"
+			  }
+			],
+			"is_unique_domain_enabled": true,
+			"force_https": true
+		  }
+		`)
+	})
+
+	want := &Pages{
+		URL:                   "https://group.example.io/project",
+		IsUniqueDomainEnabled: true,
+		ForceHTTPS:            true,
+		Deployments: []*PagesDeployment{
+			{
+				CreatedAt:     time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC),
+				URL:           "https://group.example.io/project/",
+				PathPrefix:    "",
+				RootDirectory: "public",
+			},
+			{
+				CreatedAt:     time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC),
+				URL:           "https://group.example.io/project/mr-42/",
+				PathPrefix:    "mr-42",
+				RootDirectory: "public",
+			},
+		},
+	}
+
+	p, _, err := client.Pages.GetPages("group/project")
+	require.NoError(t, err)
+	require.Equal(t, want, p)
+}
+
 func TestUpdatePages(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/projects/2/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -122,3 +175,111 @@ func TestUpdatePages(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, want, p)
 }
+
+func TestListPagesDeployments(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/pages/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testParams(t, r, "active=true&page=1&per_page=1")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{
+				"id": 7,
+				"created_at": "2021-04-27T21:27:38.584Z",
+				"url": "https://ssl.domain.example/",
+				"path_prefix": "",
+				"active": true,
+				"size": 2048,
+				"file_count": 12,
+				"expires_at": null
+			}]`)
+		case "2":
+			testParams(t, r, "active=true&page=2&per_page=1")
+			fmt.Fprint(w, `[{
+				"id": 8,
+				"created_at": "2021-04-28T08:00:00Z",
+				"url": "https://ssl.domain.example/docs/",
+				"path_prefix": "docs",
+				"active": true,
+				"size": 1024,
+				"file_count": 3,
+				"expires_at": "2021-05-28T08:00:00Z"
+			}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	expiresAt := time.Date(2021, time.May, 28, 8, 0, 0, 0, time.UTC)
+	want := []*PagesDeployment{
+		{
+			ID:        7,
+			CreatedAt: time.Date(2021, time.April, 27, 21, 27, 38, 584000000, time.UTC),
+			URL:       "https://ssl.domain.example/",
+			Active:    true,
+			Size:      2048,
+			FileCount: 12,
+		},
+		{
+			ID:         8,
+			CreatedAt:  time.Date(2021, time.April, 28, 8, 0, 0, 0, time.UTC),
+			URL:        "https://ssl.domain.example/docs/",
+			PathPrefix: "docs",
+			Active:     true,
+			Size:       1024,
+			FileCount:  3,
+			ExpiresAt:  &expiresAt,
+		},
+	}
+
+	opt := &ListPagesDeploymentsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 1},
+		Active:      Ptr(true),
+	}
+
+	ds, resp, err := client.Pages.ListPagesDeployments(2, opt)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.NextPage)
+	require.Equal(t, want[:1], ds)
+
+	opt.Page = resp.NextPage
+	ds, resp, err = client.Pages.ListPagesDeployments(2, opt)
+	require.NoError(t, err)
+	require.Equal(t, 0, resp.NextPage)
+	require.Equal(t, want[1:], ds)
+}
+
+func TestGetPagesDeployment(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/pages/deployments/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 7,
+			"created_at": "2021-04-27T21:27:38.584Z",
+			"url": "https://ssl.domain.example/",
+			"path_prefix": "",
+			"root_directory": "public",
+			"active": false,
+			"size": 2048,
+			"file_count": 12,
+			"expires_at": null
+		}`)
+	})
+
+	want := &PagesDeployment{
+		ID:            7,
+		CreatedAt:     time.Date(2021, time.April, 27, 21, 27, 38, 584000000, time.UTC),
+		URL:           "https://ssl.domain.example/",
+		RootDirectory: "public",
+		Size:          2048,
+		FileCount:     12,
+	}
+
+	d, resp, err := client.Pages.GetPagesDeployment(2, 7)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, d)
+}
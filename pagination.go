@@ -0,0 +1,119 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"errors"
+)
+
+// ErrMaxPagesExceeded is returned by Scan and Collect when more pages are
+// available than allowed by WithMaxPages.
+var ErrMaxPagesExceeded = errors.New("maximum number of pages exceeded")
+
+// PaginatableFunc describes a list function which can be paginated by
+// passing additional request options to it. List methods of the different
+// services are easily adapted to it using a closure, for example:
+//
+//	func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+//		return client.Projects.ListProjects(opt, options...)
+//	}
+type PaginatableFunc[T any] func(options ...RequestOptionFunc) ([]T, *Response, error)
+
+// Scan returns an iterator over all items returned by a paginated list
+// function. It transparently requests the next page until no more pages are
+// available, supporting both offset-based and keyset-based pagination.
+//
+// The iterator has the signature of an iter.Seq2[T, error], so with Go 1.23
+// or newer it can be used in a range loop. With older Go versions it is
+// called with a function which returns false to stop the iteration.
+//
+// The given request options are applied to every page request. Iteration
+// stops after the first error, which is yielded together with the zero value
+// of T. Use WithMaxPages to guard against unexpectedly long (or endless)
+// paginations.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/rest/index.html#pagination
+func Scan[T any](f PaginatableFunc[T], options ...RequestOptionFunc) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		pageOptions := options
+
+		for page := 1; ; page++ {
+			items, resp, err := f(pageOptions...)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if resp.NextLink == "" && resp.NextPage == 0 {
+				return
+			}
+
+			if maxPages := maxPagesFromResponse(resp); maxPages > 0 && page >= maxPages {
+				var zero T
+				yield(zero, ErrMaxPagesExceeded)
+				return
+			}
+
+			// Build the options for the next page request. Callers are free to
+			// reuse the given options slice, so we always create a new one.
+			pageOptions = append(make([]RequestOptionFunc, 0, len(options)+1), options...)
+
+			if resp.NextLink != "" {
+				pageOptions = append(pageOptions, WithKeysetPaginationParameters(resp.NextLink))
+			} else {
+				pageOptions = append(pageOptions, WithPage(resp.NextPage))
+			}
+		}
+	}
+}
+
+// Collect requests all pages of a paginated list function and returns all
+// items in a single slice. See Scan for details.
+//
+// When an error occurs, the items collected so far are returned together with
+// the error. This includes ErrMaxPagesExceeded, so callers can still use the
+// items of the allowed pages.
+func Collect[T any](f PaginatableFunc[T], options ...RequestOptionFunc) ([]T, error) {
+	var items []T
+	var err error
+	Scan(f, options...)(func(item T, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		items = append(items, item)
+		return true
+	})
+	return items, err
+}
+
+// maxPagesFromResponse returns the limit set by WithMaxPages for the request
+// of the given response, or 0 when no limit was set.
+func maxPagesFromResponse(resp *Response) int {
+	if resp == nil || resp.Response == nil || resp.Request == nil {
+		return 0
+	}
+	maxPages, _ := resp.Request.Context().Value(maxPagesKey{}).(int)
+	return maxPages
+}
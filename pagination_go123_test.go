@@ -0,0 +1,63 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//go:build go1.23
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScan_RangeOverFunc(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		switch page := r.URL.Query().Get("page"); page {
+		case "", "1":
+			w.Header().Set(xNextPage, "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			w.Header().Set(xNextPage, "3")
+			fmt.Fprint(w, `[{"id":3},{"id":4}]`)
+		default:
+			t.Fatalf("unexpected page requested: %s", page)
+		}
+	})
+
+	list := func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return client.Projects.ListProjects(nil, options...)
+	}
+
+	// With Go 1.23 the iterator returned by Scan can be used in a range loop.
+	var ids []int
+	for p, err := range Scan(list) {
+		require.NoError(t, err)
+		if p.ID == 4 {
+			break
+		}
+		ids = append(ids, p.ID)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
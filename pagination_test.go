@@ -0,0 +1,264 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScan_OffsetPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "admin", r.Header.Get("SUDO"))
+
+		switch page := r.URL.Query().Get("page"); page {
+		case "", "1":
+			w.Header().Set(xNextPage, "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			w.Header().Set(xNextPage, "3")
+			fmt.Fprint(w, `[{"id":3},{"id":4}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":5}]`)
+		default:
+			t.Fatalf("unexpected page requested: %s", page)
+		}
+	})
+
+	opt := &ListProjectsOptions{ListOptions: ListOptions{PerPage: 2}}
+	list := func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return client.Projects.ListProjects(opt, options...)
+	}
+
+	var ids []int
+	Scan(list, WithSudo("admin"))(func(p *Project, err error) bool {
+		require.NoError(t, err)
+		ids = append(ids, p.ID)
+		return true
+	})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+}
+
+func TestScan_KeysetPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "keyset", r.URL.Query().Get("pagination"))
+
+		switch idAfter := r.URL.Query().Get("id_after"); idAfter {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%sprojects?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc>; rel="next"`,
+				client.BaseURL(),
+			))
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Fatalf("unexpected id_after requested: %s", idAfter)
+		}
+	})
+
+	opt := &ListProjectsOptions{
+		ListOptions: ListOptions{Pagination: "keyset", PerPage: 2, OrderBy: "id", Sort: "asc"},
+	}
+	list := func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return client.Projects.ListProjects(opt, options...)
+	}
+
+	var ids []int
+	Scan(list)(func(p *Project, err error) bool {
+		require.NoError(t, err)
+		ids = append(ids, p.ID)
+		return true
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestListOptions_KeysetCursor(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "cursor=eyJpZCI6IjQyIn0&order_by=id&pagination=keyset&per_page=20&sort=desc")
+		fmt.Fprint(w, `[{"id":41},{"id":40}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=20")
+		fmt.Fprint(w, `[]`)
+	})
+
+	opt := &ListAuditEventsOptions{
+		ListOptions: ListOptions{
+			Pagination: "keyset",
+			PerPage:    20,
+			OrderBy:    "id",
+			Sort:       "desc",
+			Cursor:     "eyJpZCI6IjQyIn0",
+		},
+	}
+	events, _, err := client.AuditEvents.ListGroupAuditEvents(1, opt)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// Keyset parameters are only encoded when set.
+	opt = &ListAuditEventsOptions{ListOptions: ListOptions{Page: 2, PerPage: 20}}
+	_, _, err = client.AuditEvents.ListGroupAuditEvents(2, opt)
+	require.NoError(t, err)
+}
+
+func TestScan_ErrorOnPage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message":"bad request"}`)
+			return
+		}
+
+		w.Header().Set(xNextPage, "2")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	list := func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return client.Projects.ListProjects(nil, options...)
+	}
+
+	var ids []int
+	var errs []error
+	Scan(list)(func(p *Project, err error) bool {
+		if err != nil {
+			errs = append(errs, err)
+			return true
+		}
+		ids = append(ids, p.ID)
+		return true
+	})
+
+	assert.Equal(t, []int{1, 2}, ids)
+	require.Len(t, errs, 1)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, errs[0], &errResp)
+	assert.Equal(t, http.StatusBadRequest, errResp.Response.StatusCode)
+}
+
+func TestScan_StopEarly(t *testing.T) {
+	mux, client := setup(t)
+
+	requests := 0
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+
+		w.Header().Set(xNextPage, "2")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	list := func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return client.Projects.ListProjects(nil, options...)
+	}
+
+	Scan(list)(func(p *Project, err error) bool {
+		require.NoError(t, err)
+		return p.ID != 2
+	})
+
+	assert.Equal(t, 1, requests)
+}
+
+// handleThreePages registers a handler on the given path which serves three
+// pages of two items each, using offset-based pagination.
+func handleThreePages(t *testing.T, mux *http.ServeMux, path string) *int {
+	requests := 0
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+
+		switch page := r.URL.Query().Get("page"); page {
+		case "", "1":
+			w.Header().Set(xNextPage, "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			w.Header().Set(xNextPage, "3")
+			fmt.Fprint(w, `[{"id":3},{"id":4}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":5},{"id":6}]`)
+		default:
+			t.Fatalf("unexpected page requested: %s", page)
+		}
+	})
+	return &requests
+}
+
+func TestCollect_Helpers(t *testing.T) {
+	mux, client := setup(t)
+
+	handleThreePages(t, mux, "/api/v4/projects")
+	handleThreePages(t, mux, "/api/v4/groups/1/members")
+	handleThreePages(t, mux, "/api/v4/projects/1/pipelines/2/jobs")
+
+	projects, err := client.Projects.CollectProjects(nil)
+	require.NoError(t, err)
+	require.Len(t, projects, 6)
+	assert.Equal(t, 6, projects[5].ID)
+
+	members, err := client.Groups.CollectGroupMembers(1, nil)
+	require.NoError(t, err)
+	require.Len(t, members, 6)
+	assert.Equal(t, 6, members[5].ID)
+
+	jobs, err := client.Jobs.CollectPipelineJobs(1, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, 6)
+	assert.Equal(t, 6, jobs[5].ID)
+}
+
+func TestCollect_WithMaxPages(t *testing.T) {
+	mux, client := setup(t)
+
+	requests := handleThreePages(t, mux, "/api/v4/projects")
+
+	projects, err := client.Projects.CollectProjects(nil, WithMaxPages(2))
+	require.ErrorIs(t, err, ErrMaxPagesExceeded)
+	assert.Equal(t, 2, *requests)
+
+	// The items of the allowed pages are still returned.
+	require.Len(t, projects, 4)
+	assert.Equal(t, 4, projects[3].ID)
+
+	// A limit which covers all pages does not return an error.
+	*requests = 0
+	projects, err = client.Projects.CollectProjects(nil, WithMaxPages(3))
+	require.NoError(t, err)
+	assert.Len(t, projects, 6)
+	assert.Equal(t, 3, *requests)
+}
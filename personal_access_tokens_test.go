@@ -237,6 +237,7 @@ func TestRotatePersonalAccessToken(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/personal_access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2023-08-15"}`)
 		mustWriteHTTPResponse(t, w, "testdata/rotate_personal_access_token.json")
 	})
 
@@ -271,6 +272,7 @@ func TestRotatePersonalAccessTokenByID(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/personal_access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2023-08-15"}`)
 		mustWriteHTTPResponse(t, w, "testdata/rotate_personal_access_token.json")
 	})
 
@@ -305,6 +307,7 @@ func TestRotatePersonalAccessTokenSelf(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/personal_access_tokens/self/rotate", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"expires_at":"2023-08-15"}`)
 		mustWriteHTTPResponse(t, w, "testdata/rotate_personal_access_token.json")
 	})
 
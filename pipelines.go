@@ -43,28 +43,28 @@ type PipelineVariable struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/pipelines.html
 type Pipeline struct {
-	ID             int             `json:"id"`
-	IID            int             `json:"iid"`
-	ProjectID      int             `json:"project_id"`
-	Status         string          `json:"status"`
-	Source         string          `json:"source"`
-	Ref            string          `json:"ref"`
-	Name           string          `json:"name"`
-	SHA            string          `json:"sha"`
-	BeforeSHA      string          `json:"before_sha"`
-	Tag            bool            `json:"tag"`
-	YamlErrors     string          `json:"yaml_errors"`
-	User           *BasicUser      `json:"user"`
-	UpdatedAt      *time.Time      `json:"updated_at"`
-	CreatedAt      *time.Time      `json:"created_at"`
-	StartedAt      *time.Time      `json:"started_at"`
-	FinishedAt     *time.Time      `json:"finished_at"`
-	CommittedAt    *time.Time      `json:"committed_at"`
-	Duration       int             `json:"duration"`
-	QueuedDuration int             `json:"queued_duration"`
-	Coverage       string          `json:"coverage"`
-	WebURL         string          `json:"web_url"`
-	DetailedStatus *DetailedStatus `json:"detailed_status"`
+	ID             int                 `json:"id"`
+	IID            int                 `json:"iid"`
+	ProjectID      int                 `json:"project_id"`
+	Status         PipelineStatusValue `json:"status"`
+	Source         string              `json:"source"`
+	Ref            string              `json:"ref"`
+	Name           string              `json:"name"`
+	SHA            string              `json:"sha"`
+	BeforeSHA      string              `json:"before_sha"`
+	Tag            bool                `json:"tag"`
+	YamlErrors     string              `json:"yaml_errors"`
+	User           *BasicUser          `json:"user"`
+	UpdatedAt      *time.Time          `json:"updated_at"`
+	CreatedAt      *time.Time          `json:"created_at"`
+	StartedAt      *time.Time          `json:"started_at"`
+	FinishedAt     *time.Time          `json:"finished_at"`
+	CommittedAt    *time.Time          `json:"committed_at"`
+	Duration       int                 `json:"duration"`
+	QueuedDuration int                 `json:"queued_duration"`
+	Coverage       string              `json:"coverage"`
+	WebURL         string              `json:"web_url"`
+	DetailedStatus *DetailedStatus     `json:"detailed_status"`
 }
 
 // DetailedStatus contains detailed information about the status of a pipeline.
@@ -132,19 +132,54 @@ func (p PipelineTestReport) String() string {
 	return Stringify(p)
 }
 
+// PipelineTestReportSummary contains a summary of the test report of a
+// pipeline.
+type PipelineTestReportSummary struct {
+	Total      *PipelineTotalSummary       `json:"total"`
+	TestSuites []*PipelineTestSuiteSummary `json:"test_suites"`
+}
+
+// PipelineTotalSummary contains the total summary of all test suites.
+type PipelineTotalSummary struct {
+	Time       float64 `json:"time"`
+	Count      int     `json:"count"`
+	Success    int     `json:"success"`
+	Failed     int     `json:"failed"`
+	Skipped    int     `json:"skipped"`
+	Error      int     `json:"error"`
+	SuiteError string  `json:"suite_error"`
+}
+
+// PipelineTestSuiteSummary contains the summary of a single test suite.
+type PipelineTestSuiteSummary struct {
+	Name         string  `json:"name"`
+	TotalTime    float64 `json:"total_time"`
+	TotalCount   int     `json:"total_count"`
+	SuccessCount int     `json:"success_count"`
+	FailedCount  int     `json:"failed_count"`
+	SkippedCount int     `json:"skipped_count"`
+	ErrorCount   int     `json:"error_count"`
+	BuildIDs     []int   `json:"build_ids"`
+	SuiteError   string  `json:"suite_error"`
+}
+
+func (p PipelineTestReportSummary) String() string {
+	return Stringify(p)
+}
+
 // PipelineInfo shows the basic entities of a pipeline, mostly used as fields
 // on other assets, like Commit.
 type PipelineInfo struct {
-	ID        int        `json:"id"`
-	IID       int        `json:"iid"`
-	ProjectID int        `json:"project_id"`
-	Status    string     `json:"status"`
-	Source    string     `json:"source"`
-	Ref       string     `json:"ref"`
-	SHA       string     `json:"sha"`
-	WebURL    string     `json:"web_url"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID        int                 `json:"id"`
+	IID       int                 `json:"iid"`
+	ProjectID int                 `json:"project_id"`
+	Status    PipelineStatusValue `json:"status"`
+	Source    string              `json:"source"`
+	Ref       string              `json:"ref"`
+	SHA       string              `json:"sha"`
+	WebURL    string              `json:"web_url"`
+	UpdatedAt *time.Time          `json:"updated_at"`
+	CreatedAt *time.Time          `json:"created_at"`
 }
 
 func (p PipelineInfo) String() string {
@@ -272,6 +307,32 @@ func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int,
 	return p, resp, nil
 }
 
+// GetPipelineTestReportSummary gets the test report summary of a single
+// project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report-summary
+func (s *PipelinesService) GetPipelineTestReportSummary(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReportSummary, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/test_report_summary", PathEscape(project), pipeline)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(PipelineTestReportSummary)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
+
 // GetLatestPipelineOptions represents the available GetLatestPipeline() options.
 //
 // GitLab API docs:
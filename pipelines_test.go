@@ -169,6 +169,122 @@ func TestGetPipelineTestReport(t *testing.T) {
 	}
 }
 
+func TestGetPipelineTestReportWithFailedCases(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/123456/test_report", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total_time": 3.5,
+			"total_count": 3,
+			"success_count": 1,
+			"failed_count": 2,
+			"test_suites": [
+				{
+					"name": "unit",
+					"total_time": 1.5,
+					"total_count": 2,
+					"success_count": 1,
+					"failed_count": 1,
+					"test_cases": [
+						{"status": "success", "name": "TestOK", "execution_time": 0.5},
+						{"status": "failed", "name": "TestFlaky", "execution_time": 1, "stack_trace": "flaky_test.go:12: boom"}
+					]
+				},
+				{
+					"name": "integration",
+					"total_time": 2,
+					"total_count": 1,
+					"failed_count": 1,
+					"test_cases": [
+						{"status": "failed", "name": "TestDB", "execution_time": 2, "stack_trace": "db_test.go:42: timeout"}
+					]
+				}
+			]
+		}`)
+	})
+
+	testreport, _, err := client.Pipelines.GetPipelineTestReport(1, 123456)
+	if err != nil {
+		t.Fatalf("Pipelines.GetPipelineTestReport returned error: %v", err)
+	}
+
+	want := &PipelineTestReport{
+		TotalTime:    3.5,
+		TotalCount:   3,
+		SuccessCount: 1,
+		FailedCount:  2,
+		TestSuites: []*PipelineTestSuites{
+			{
+				Name:         "unit",
+				TotalTime:    1.5,
+				TotalCount:   2,
+				SuccessCount: 1,
+				FailedCount:  1,
+				TestCases: []*PipelineTestCases{
+					{Status: "success", Name: "TestOK", ExecutionTime: 0.5},
+					{Status: "failed", Name: "TestFlaky", ExecutionTime: 1, StackTrace: "flaky_test.go:12: boom"},
+				},
+			},
+			{
+				Name:        "integration",
+				TotalTime:   2,
+				TotalCount:  1,
+				FailedCount: 1,
+				TestCases: []*PipelineTestCases{
+					{Status: "failed", Name: "TestDB", ExecutionTime: 2, StackTrace: "db_test.go:42: timeout"},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, testreport) {
+		t.Errorf("Pipelines.GetPipelineTestReport returned %+v, want %+v", testreport, want)
+	}
+}
+
+func TestGetPipelineTestReportSummary(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/123456/test_report_summary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		mustWriteHTTPResponse(t, w, "testdata/get_pipeline_testreport_summary.json")
+	})
+
+	summary, _, err := client.Pipelines.GetPipelineTestReportSummary(1, 123456)
+	if err != nil {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned error: %v", err)
+	}
+
+	want := &PipelineTestReportSummary{
+		Total: &PipelineTotalSummary{
+			Time:    1904,
+			Count:   3363,
+			Success: 3350,
+			Failed:  1,
+			Skipped: 12,
+		},
+		TestSuites: []*PipelineTestSuiteSummary{
+			{
+				Name:         "test",
+				TotalTime:    1904,
+				TotalCount:   3363,
+				SuccessCount: 3350,
+				FailedCount:  1,
+				SkippedCount: 12,
+				BuildIDs:     []int{66004},
+			},
+			{
+				Name:       "rspec",
+				BuildIDs:   []int{66005},
+				SuiteError: "JUnit XML parsing failed: 1:1: FATAL: Document is empty.",
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, summary) {
+		t.Errorf("Pipelines.GetPipelineTestReportSummary returned %+v, want %+v", summary, want)
+	}
+}
+
 func TestGetLatestPipeline(t *testing.T) {
 	mux, client := setup(t)
 
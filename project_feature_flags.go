@@ -44,6 +44,7 @@ type ProjectFeatureFlagStrategy struct {
 	Name       string                               `json:"name"`
 	Parameters *ProjectFeatureFlagStrategyParameter `json:"parameters"`
 	Scopes     []*ProjectFeatureFlagScope           `json:"scopes"`
+	UserList   *FeatureFlagUserList                 `json:"user_list"`
 }
 
 // ProjectFeatureFlagStrategyParameter is used in updating and creating feature flags
@@ -147,6 +148,7 @@ type FeatureFlagStrategyOptions struct {
 	Name       *string                              `url:"name,omitempty" json:"name,omitempty"`
 	Parameters *ProjectFeatureFlagStrategyParameter `url:"parameters,omitempty" json:"parameters,omitempty"`
 	Scopes     *[]*ProjectFeatureFlagScope          `url:"scopes,omitempty" json:"scopes,omitempty"`
+	UserListID *int                                 `url:"user_list_id,omitempty" json:"user_list_id,omitempty"`
 }
 
 // ProjectFeatureFlagScopeOptions represents the available feature flag scope
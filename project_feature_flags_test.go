@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -141,14 +142,19 @@ func TestGetProjectFeatureFlag(t *testing.T) {
 func TestCreateProjectFeatureFlag(t *testing.T) {
 	mux, client := setup(t)
 
-	mux.HandleFunc("/api/v4/projects/1/feature_flags/testing", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, http.MethodPut)
+	mux.HandleFunc("/api/v4/projects/1/feature_flags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"awesome_feature","version":"new_version_flag","active":true}`)
 		mustWriteHTTPResponse(t, w, "testdata/create_project_feature_flag.json")
 	})
 
-	actual, _, err := client.ProjectFeatureFlags.UpdateProjectFeatureFlag(1, "testing", &UpdateProjectFeatureFlagOptions{})
+	actual, _, err := client.ProjectFeatureFlags.CreateProjectFeatureFlag(1, &CreateProjectFeatureFlagOptions{
+		Name:    Ptr("awesome_feature"),
+		Version: Ptr("new_version_flag"),
+		Active:  Ptr(true),
+	})
 	if err != nil {
-		t.Errorf("ProjectFeatureFlags.UpdateProjectFeatureFlag returned error: %v", err)
+		t.Errorf("ProjectFeatureFlags.CreateProjectFeatureFlag returned error: %v", err)
 		return
 	}
 
@@ -180,6 +186,91 @@ func TestCreateProjectFeatureFlag(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestCreateProjectFeatureFlagGradualRollout(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"new_checkout","strategies":[{"name":"gradualRolloutUserId","parameters":{"groupId":"default","percentage":"25"},"scopes":[{"id":0,"environment_scope":"production"}]},{"name":"gitlabUserList","user_list_id":3}]}`)
+		fmt.Fprint(w, `{
+			"name": "new_checkout",
+			"active": true,
+			"version": "new_version_flag",
+			"scopes": [],
+			"strategies": [
+				{
+					"id": 1,
+					"name": "gradualRolloutUserId",
+					"parameters": {"groupId": "default", "percentage": "25"},
+					"scopes": [{"id": 1, "environment_scope": "production"}],
+					"user_list": null
+				},
+				{
+					"id": 2,
+					"name": "gitlabUserList",
+					"parameters": {},
+					"scopes": [],
+					"user_list": {"id": 5, "iid": 3, "name": "beta_testers", "user_xids": "user1,user2"}
+				}
+			]
+		}`)
+	})
+
+	actual, _, err := client.ProjectFeatureFlags.CreateProjectFeatureFlag(1, &CreateProjectFeatureFlagOptions{
+		Name: Ptr("new_checkout"),
+		Strategies: &[]*FeatureFlagStrategyOptions{
+			{
+				Name: Ptr("gradualRolloutUserId"),
+				Parameters: &ProjectFeatureFlagStrategyParameter{
+					GroupID:    "default",
+					Percentage: "25",
+				},
+				Scopes: &[]*ProjectFeatureFlagScope{{EnvironmentScope: "production"}},
+			},
+			{
+				Name:       Ptr("gitlabUserList"),
+				UserListID: Ptr(3),
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("ProjectFeatureFlags.CreateProjectFeatureFlag returned error: %v", err)
+		return
+	}
+
+	expected := &ProjectFeatureFlag{
+		Name:    "new_checkout",
+		Active:  true,
+		Version: "new_version_flag",
+		Scopes:  []*ProjectFeatureFlagScope{},
+		Strategies: []*ProjectFeatureFlagStrategy{
+			{
+				ID:   1,
+				Name: "gradualRolloutUserId",
+				Parameters: &ProjectFeatureFlagStrategyParameter{
+					GroupID:    "default",
+					Percentage: "25",
+				},
+				Scopes: []*ProjectFeatureFlagScope{{ID: 1, EnvironmentScope: "production"}},
+			},
+			{
+				ID:         2,
+				Name:       "gitlabUserList",
+				Parameters: &ProjectFeatureFlagStrategyParameter{},
+				Scopes:     []*ProjectFeatureFlagScope{},
+				UserList: &FeatureFlagUserList{
+					ID:       5,
+					IID:      3,
+					Name:     "beta_testers",
+					UserXIDs: "user1,user2",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
 func TestUpdateProjectFeatureFlag(t *testing.T) {
 	mux, client := setup(t)
 
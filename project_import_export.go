@@ -18,10 +18,23 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+var (
+	// ErrExportFailed is returned by AwaitExport when the project export failed.
+	ErrExportFailed = errors.New("project export failed")
+
+	// ErrExportNotStarted is returned by AwaitExport when no export was
+	// scheduled for the project.
+	ErrExportNotStarted = errors.New("project export not started")
 )
 
 // ProjectImportExportService handles communication with the project
@@ -135,6 +148,74 @@ func (s *ProjectImportExportService) ExportStatus(pid interface{}, options ...Re
 	return es, resp, nil
 }
 
+// AwaitExport polls the export status of a project until the export is
+// finished or failed, and returns the final status. The download link of a
+// finished export is available in the Links of the returned status. When the
+// export failed, the status is returned together with ErrExportFailed. When no
+// export was scheduled, the status is returned together with
+// ErrExportNotStarted. An unknown status is returned together with an error.
+//
+// The status is polled every pollInterval (5 seconds when zero). When timeout
+// is greater than zero, AwaitExport gives up after the timeout and returns the
+// error of the context. Use WithContext to stop polling early.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_import_export.html#export-status
+func (s *ProjectImportExportService) AwaitExport(pid interface{}, pollInterval, timeout time.Duration, options ...RequestOptionFunc) (*ExportStatus, *Response, error) {
+	// The context used to stop polling is taken from the first request, so it
+	// honors a context passed with WithContext.
+	var ctx context.Context
+	cancel := func() {}
+	defer func() { cancel() }()
+
+	options = append(options[:len(options):len(options)], func(req *retryablehttp.Request) error {
+		if ctx == nil {
+			ctx = req.Context()
+			if timeout > 0 {
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+			}
+		}
+		*req = *req.WithContext(ctx)
+		return nil
+	})
+
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+
+	for {
+		es, resp, err := s.ExportStatus(pid, options...)
+		if err != nil {
+			if ctx != nil && ctx.Err() != nil {
+				return nil, resp, ctx.Err()
+			}
+			return nil, resp, err
+		}
+
+		switch es.ExportStatus {
+		case "queued", "started", "regeneration_in_progress":
+			// The export is still running, so poll again.
+		case "finished":
+			return es, resp, nil
+		case "failed":
+			if es.Message != "" {
+				return es, resp, fmt.Errorf("%w: %s", ErrExportFailed, es.Message)
+			}
+			return es, resp, ErrExportFailed
+		case "none":
+			return es, resp, ErrExportNotStarted
+		default:
+			return es, resp, fmt.Errorf("unexpected project export status %q", es.ExportStatus)
+		}
+
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		}
+	}
+}
+
 // ExportDownload download the finished export.
 //
 // GitLab API docs:
@@ -2,9 +2,11 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -218,3 +220,105 @@ func TestProjectImportExportService_ImportStatus(t *testing.T) {
 	require.Nil(t, es)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectImportExportService_AwaitExport(t *testing.T) {
+	mux, client := setup(t)
+
+	statuses := []string{"queued", "started", "finished"}
+	var polls int
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		status := statuses[len(statuses)-1]
+		if polls < len(statuses) {
+			status = statuses[polls]
+		}
+		polls++
+		fmt.Fprintf(w, `{
+			"id": 1,
+			"name": "Gitlab Test",
+			"export_status": %q,
+			"_links": {
+				"api_url": "https://gitlab.example.com/api/v4/projects/1/export/download",
+				"web_url": "https://gitlab.example.com/gitlab-org/gitlab-test/download_export"
+			}
+		}`, status)
+	})
+
+	es, resp, err := client.ProjectImportExport.AwaitExport(1, time.Millisecond, time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, 3, polls)
+	require.Equal(t, "finished", es.ExportStatus)
+	require.Equal(t, "https://gitlab.example.com/api/v4/projects/1/export/download", es.Links.APIURL)
+}
+
+func TestProjectImportExportService_AwaitExportFailed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "export_status": "failed", "message": "Export failed: storage unavailable"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.AwaitExport(1, time.Millisecond, 0)
+	require.ErrorIs(t, err, ErrExportFailed)
+	require.EqualError(t, err, "project export failed: Export failed: storage unavailable")
+	require.Equal(t, "failed", es.ExportStatus)
+}
+
+func TestProjectImportExportService_AwaitExportNotStarted(t *testing.T) {
+	mux, client := setup(t)
+
+	var polls int
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		polls++
+		fmt.Fprint(w, `{"id": 1, "export_status": "none"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.AwaitExport(1, time.Millisecond, 0)
+	require.ErrorIs(t, err, ErrExportNotStarted)
+	require.Equal(t, "none", es.ExportStatus)
+	require.Equal(t, 1, polls)
+}
+
+func TestProjectImportExportService_AwaitExportUnknownStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "export_status": "archived"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.AwaitExport(1, time.Millisecond, 0)
+	require.EqualError(t, err, `unexpected project export status "archived"`)
+	require.Equal(t, "archived", es.ExportStatus)
+}
+
+func TestProjectImportExportService_AwaitExportTimeout(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "export_status": "started"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.AwaitExport(1, time.Millisecond, 20*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, es)
+}
+
+func TestProjectImportExportService_AwaitExportCanceled(t *testing.T) {
+	mux, client := setup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		cancel()
+		fmt.Fprint(w, `{"id": 1, "export_status": "queued"}`)
+	})
+
+	es, _, err := client.ProjectImportExport.AwaitExport(1, time.Hour, 0, WithContext(ctx))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, es)
+}
@@ -46,3 +46,28 @@ func TestListProjectIterations(t *testing.T) {
 		t.Errorf("ProjectIterations.ListProjectIterations returned %+v, want %+v", iterations, want)
 	}
 }
+
+func TestListProjectIterationsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/42/iterations",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "include_ancestors=true&search=Iteration&state=opened")
+			fmt.Fprint(w, `[{"id": 53, "iid": 13, "title": "Iteration II", "state": 2}]`)
+		})
+
+	iterations, _, err := client.ProjectIterations.ListProjectIterations(42, &ListProjectIterationsOptions{
+		State:            Ptr("opened"),
+		Search:           Ptr("Iteration"),
+		IncludeAncestors: Ptr(true),
+	})
+	if err != nil {
+		t.Errorf("ProjectIterations.ListProjectIterations returned error: %v", err)
+	}
+
+	want := []*ProjectIteration{{ID: 53, IID: 13, Title: "Iteration II", State: 2}}
+	if !reflect.DeepEqual(want, iterations) {
+		t.Errorf("ProjectIterations.ListProjectIterations returned %+v, want %+v", iterations, want)
+	}
+}
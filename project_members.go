@@ -169,6 +169,17 @@ func (s *ProjectMembersService) AddProjectMember(pid interface{}, opt *AddProjec
 	}
 	u := fmt.Sprintf("projects/%s/members", PathEscape(project))
 
+	// Fall back to the default access level of the client (if any), without
+	// modifying the options of the caller.
+	if s.client.defaultAccessLevel != nil && (opt == nil || opt.AccessLevel == nil) {
+		o := AddProjectMemberOptions{}
+		if opt != nil {
+			o = *opt
+		}
+		o.AccessLevel = s.client.defaultAccessLevel
+		opt = &o
+	}
+
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
 	if err != nil {
 		return nil, nil, err
@@ -367,3 +367,58 @@ func TestProjectMembersService_DeleteProjectMember(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestAddProjectMemberDefaultAccessLevel(t *testing.T) {
+	mux, client := setup(t)
+
+	client, err := NewClient("", WithBaseURL(client.BaseURL().String()), WithDefaultAccessLevel(ReporterPermissions))
+	require.NoError(t, err)
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/projects/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `{"id":2,"access_level":20}`)
+	})
+
+	// The default is applied when no access level is given.
+	wantBody = `{"user_id":2,"access_level":20,"expires_at":null}`
+	opt := &AddProjectMemberOptions{UserID: 2}
+	_, _, err = client.ProjectMembers.AddProjectMember(1, opt)
+	require.NoError(t, err)
+	require.Nil(t, opt.AccessLevel, "the options of the caller must not be modified")
+
+	// An explicit access level always wins.
+	wantBody = `{"user_id":2,"access_level":40,"expires_at":null}`
+	_, _, err = client.ProjectMembers.AddProjectMember(1, &AddProjectMemberOptions{
+		UserID:      2,
+		AccessLevel: Ptr(MaintainerPermissions),
+	})
+	require.NoError(t, err)
+}
+
+func TestListAllProjectMembersWithUserIDs(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "query=john&user_ids%5B%5D=3&user_ids%5B%5D=7")
+		fmt.Fprint(w, `[
+			{"id": 3, "username": "john_smith", "access_level": 30},
+			{"id": 7, "username": "john_doe", "access_level": 50}
+		]`)
+	})
+
+	opt := &ListProjectMembersOptions{
+		Query:   Ptr("john"),
+		UserIDs: &[]int{3, 7},
+	}
+	members, _, err := client.ProjectMembers.ListAllProjectMembers(1, opt)
+	require.NoError(t, err)
+
+	want := []*ProjectMember{
+		{ID: 3, Username: "john_smith", AccessLevel: DeveloperPermissions},
+		{ID: 7, Username: "john_doe", AccessLevel: OwnerPermissions},
+	}
+	require.Equal(t, want, members)
+}
@@ -392,3 +392,23 @@ func TestProjectSnippetsService_SnippetContent(t *testing.T) {
 	require.Nil(t, s)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectSnippetsService_SnippetFileContent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/group/project/snippets/1/files/main/lib/add.rb/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/group%2Fproject/snippets/1/files/main/lib%2Fadd%2Erb/raw")
+		fmt.Fprint(w, "def add(a, b)\n  a + b\nend\n")
+	})
+
+	b, resp, err := client.ProjectSnippets.SnippetFileContent("group/project", 1, "main", "lib/add.rb")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []byte("def add(a, b)\n  a + b\nend\n"), b)
+
+	b, resp, err = client.ProjectSnippets.SnippetFileContent("group/project", 2, "main", "missing.rb")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Nil(t, b)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
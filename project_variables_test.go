@@ -105,6 +105,19 @@ func TestProjectVariablesService_GetVariable(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestProjectVariablesService_GetVariableWithoutFilter(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/TEST_VARIABLE_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "")
+		fmt.Fprint(w, `{"key": "TEST_VARIABLE_1", "value": "TEST_1"}`)
+	})
+
+	_, _, err := client.ProjectVariables.GetVariable(1, "TEST_VARIABLE_1", &GetProjectVariableOptions{Filter: &VariableFilter{}}, nil)
+	require.NoError(t, err)
+}
+
 func TestProjectVariablesService_CreateVariable(t *testing.T) {
 	mux, client := setup(t)
 
@@ -232,3 +245,51 @@ func TestProjectVariablesService_RemoveVariable(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectVariablesService_CreateVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"key":"SECRET","value":"s3cr3t-value","masked":true,"protected":false,"raw":true,"variable_type":"file"}`)
+		fmt.Fprint(w, `{"key":"SECRET","value":"s3cr3t-value","variable_type":"file","protected":false,"masked":true,"raw":true,"environment_scope":"*"}`)
+	})
+
+	pv, _, err := client.ProjectVariables.CreateVariable(1, &CreateProjectVariableOptions{
+		Key:          Ptr("SECRET"),
+		Value:        Ptr("s3cr3t-value"),
+		Masked:       Ptr(true),
+		Protected:    Ptr(false),
+		Raw:          Ptr(true),
+		VariableType: Ptr(FileVariableType),
+	})
+	require.NoError(t, err)
+
+	want := &ProjectVariable{
+		Key:              "SECRET",
+		Value:            "s3cr3t-value",
+		VariableType:     FileVariableType,
+		Masked:           true,
+		Raw:              true,
+		EnvironmentScope: "*",
+	}
+	require.Equal(t, want, pv)
+}
+
+func TestProjectVariablesService_UpdateVariableFlags(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/SECRET", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"masked":false,"protected":true,"raw":false,"variable_type":"env_var"}`)
+		fmt.Fprint(w, `{"key":"SECRET","variable_type":"env_var","protected":true,"masked":false,"raw":false}`)
+	})
+
+	_, _, err := client.ProjectVariables.UpdateVariable(1, "SECRET", &UpdateProjectVariableOptions{
+		Masked:       Ptr(false),
+		Protected:    Ptr(true),
+		Raw:          Ptr(false),
+		VariableType: Ptr(EnvVariableType),
+	})
+	require.NoError(t, err)
+}
@@ -378,6 +378,16 @@ func (s *ProjectsService) ListProjects(opt *ListProjectsOptions, options ...Requ
 	return p, resp, nil
 }
 
+// CollectProjects gets all pages of the list of projects accessible by the
+// authenticated user. Use WithMaxPages to limit the number of requested pages.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#list-all-projects
+func (s *ProjectsService) CollectProjects(opt *ListProjectsOptions, options ...RequestOptionFunc) ([]*Project, error) {
+	return Collect(func(options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		return s.ListProjects(opt, options...)
+	}, options...)
+}
+
 // ListUserProjects gets a list of projects for the given user.
 //
 // GitLab API docs:
@@ -1642,7 +1652,7 @@ func (s *ProjectsService) UploadFile(pid interface{}, content io.Reader, filenam
 	return pf, resp, nil
 }
 
-// UploadAvatar uploads an avatar.
+// UploadAvatar uploads an avatar. Use DeleteAvatar to remove it again.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#upload-a-project-avatar
@@ -1675,6 +1685,35 @@ func (s *ProjectsService) UploadAvatar(pid interface{}, avatar io.Reader, filena
 	return p, resp, nil
 }
 
+// DeleteAvatar removes the avatar of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#remove-a-project-avatar
+func (s *ProjectsService) DeleteAvatar(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s", PathEscape(project))
+
+	opt := struct {
+		Avatar string `json:"avatar"`
+	}{}
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(Project)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
+
 // ListProjectForks gets a list of project forks.
 //
 // GitLab API docs:
@@ -2234,13 +2273,33 @@ func (s *ProjectsService) TransferProject(pid interface{}, opt *TransferProjectO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#start-the-housekeeping-task-for-a-project
 func (s *ProjectsService) StartHousekeepingProject(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	return s.StartHousekeeping(pid, nil, options...)
+}
+
+// StartHousekeepingOptions represents the available StartHousekeeping()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#start-the-housekeeping-task-for-a-project
+type StartHousekeepingOptions struct {
+	// Task is either "eager" to trigger a more aggressive housekeeping, or
+	// "prune" to trigger manual pruning of unreachable objects.
+	Task *string `url:"task,omitempty" json:"task,omitempty"`
+}
+
+// StartHousekeeping starts the housekeeping task for a project, optionally
+// running a specific task.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#start-the-housekeeping-task-for-a-project
+func (s *ProjectsService) StartHousekeeping(pid interface{}, opt *StartHousekeepingOptions, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/housekeeping", PathEscape(project))
 
-	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
 	if err != nil {
 		return nil, err
 	}
@@ -2248,7 +2307,7 @@ func (s *ProjectsService) StartHousekeepingProject(pid interface{}, options ...R
 	return s.client.Do(req, nil)
 }
 
-// GetRepositoryStorage Get the path to repository storage.
+// ProjectReposityStorage represents the repository storage of a project.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-the-path-to-repository-storage
@@ -2259,6 +2318,13 @@ type ProjectReposityStorage struct {
 	RepositoryStorage string     `json:"repository_storage"`
 }
 
+// GetRepositoryStorage gets the path to the repository storage of a project.
+// This endpoint is only available to administrators. The storage sizes of a
+// project are available in the Statistics of a project, which are returned by
+// GetProject when passing the Statistics option.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-the-path-to-repository-storage
 func (s *ProjectsService) GetRepositoryStorage(pid interface{}, options ...RequestOptionFunc) (*ProjectReposityStorage, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
@@ -89,6 +89,33 @@ func TestListUserProjects(t *testing.T) {
 	}
 }
 
+func TestListUserProjectsWithMinAccessLevel(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/users/johndoe/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "min_access_level=30&order_by=last_activity_at&starred=true&visibility=private")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	opt := &ListProjectsOptions{
+		MinAccessLevel: Ptr(DeveloperPermissions),
+		OrderBy:        Ptr("last_activity_at"),
+		Starred:        Ptr(true),
+		Visibility:     Ptr(PrivateVisibility),
+	}
+
+	projects, _, err := client.Projects.ListUserProjects("johndoe", opt)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjects returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListUserProjects returned %+v, want %+v", projects, want)
+	}
+}
+
 func TestListUserContributedProjects(t *testing.T) {
 	mux, client := setup(t)
 
@@ -247,6 +274,36 @@ func TestListProjectsGroupsByName(t *testing.T) {
 	}
 }
 
+func TestListProjectsGroupsWithShared(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/groups", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/api/v4/projects/1/groups?page=1&per_page=2&shared_min_access_level=30&with_shared=true")
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set(xNextPage, "2")
+		fmt.Fprint(w, `[{"id":1,"full_path":"parent"},{"id":2,"full_path":"shared"}]`)
+	})
+
+	opt := &ListProjectGroupOptions{
+		ListOptions:          ListOptions{Page: 1, PerPage: 2},
+		SharedMinAccessLevel: Ptr(DeveloperPermissions),
+		WithShared:           Ptr(true),
+	}
+
+	groups, resp, err := client.Projects.ListProjectsGroups(1, opt)
+	if err != nil {
+		t.Errorf("Projects.ListProjectsGroups returned error: %v", err)
+	}
+
+	want := []*ProjectGroup{{ID: 1, FullPath: "parent"}, {ID: 2, FullPath: "shared"}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Projects.ListProjectsGroups returned %+v, want %+v", groups, want)
+	}
+	if resp.NextPage != 2 {
+		t.Errorf("Projects.ListProjectsGroups returned next page %d, want 2", resp.NextPage)
+	}
+}
+
 func TestListOwnedProjects(t *testing.T) {
 	mux, client := setup(t)
 
@@ -431,6 +488,49 @@ func TestGetProjectByName(t *testing.T) {
 	}
 }
 
+func TestGetProjectSharedWithGroups(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"shared_with_groups": [
+				{
+					"group_id": 4,
+					"group_name": "Twitter",
+					"group_full_path": "twitter",
+					"group_access_level": 30
+				},
+				{
+					"group_id": 3,
+					"group_name": "Gitlab Org",
+					"group_full_path": "gitlab-org",
+					"group_access_level": 10
+				}
+			]
+		}`)
+	})
+
+	project, _, err := client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returns an error: %v", err)
+	}
+
+	if len(project.SharedWithGroups) != 2 {
+		t.Fatalf("Projects.GetProject returned %d shared groups, want 2", len(project.SharedWithGroups))
+	}
+
+	g := project.SharedWithGroups[0]
+	if g.GroupID != 4 || g.GroupName != "Twitter" || g.GroupFullPath != "twitter" || g.GroupAccessLevel != 30 {
+		t.Errorf("Projects.GetProject returned shared group %+v, want Twitter (4) with access level 30", g)
+	}
+	g = project.SharedWithGroups[1]
+	if g.GroupID != 3 || g.GroupName != "Gitlab Org" || g.GroupFullPath != "gitlab-org" || g.GroupAccessLevel != 10 {
+		t.Errorf("Projects.GetProject returned shared group %+v, want Gitlab Org (3) with access level 10", g)
+	}
+}
+
 func TestGetProjectWithOptions(t *testing.T) {
 	mux, client := setup(t)
 
@@ -476,6 +576,74 @@ func TestGetProjectWithOptions(t *testing.T) {
 	}
 }
 
+func TestGetProjectStatisticsParam(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "statistics=true")
+		fmt.Fprint(w, `{"id":1,"statistics":{"storage_size":2048,"repository_size":1024,"job_artifacts_size":1024}}`)
+	})
+
+	project, _, err := client.Projects.GetProject(1, &GetProjectOptions{Statistics: Ptr(true)})
+	if err != nil {
+		t.Fatalf("Projects.GetProject returns an error: %v", err)
+	}
+
+	want := &Statistics{StorageSize: 2048, RepositorySize: 1024, JobArtifactsSize: 1024}
+	if !reflect.DeepEqual(want, project.Statistics) {
+		t.Errorf("Projects.GetProject returned statistics %+v, want %+v", project.Statistics, want)
+	}
+}
+
+func TestGetRepositoryStorage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/storage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"project_id": 1,
+			"disk_path": "@hashed/6b/86/6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b",
+			"created_at": "2012-10-12T17:04:47Z",
+			"repository_storage": "default"
+		}`)
+	})
+
+	storage, _, err := client.Projects.GetRepositoryStorage(1)
+	if err != nil {
+		t.Fatalf("Projects.GetRepositoryStorage returns an error: %v", err)
+	}
+
+	want := &ProjectReposityStorage{
+		ProjectID:         1,
+		DiskPath:          "@hashed/6b/86/6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b",
+		CreatedAt:         Ptr(time.Date(2012, time.October, 12, 17, 4, 47, 0, time.UTC)),
+		RepositoryStorage: "default",
+	}
+	if !reflect.DeepEqual(want, storage) {
+		t.Errorf("Projects.GetRepositoryStorage returned %+v, want %+v", storage, want)
+	}
+}
+
+func TestGetProjectLanguages(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"Go":70.5,"Shell":29.5}`)
+	})
+
+	languages, _, err := client.Projects.GetProjectLanguages(1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectLanguages returns an error: %v", err)
+	}
+
+	want := &ProjectLanguages{"Go": 70.5, "Shell": 29.5}
+	if !reflect.DeepEqual(want, languages) {
+		t.Errorf("Projects.GetProjectLanguages returned %+v, want %+v", languages, want)
+	}
+}
+
 func TestCreateProject(t *testing.T) {
 	mux, client := setup(t)
 
@@ -578,6 +746,49 @@ func TestUploadFile_Retry(t *testing.T) {
 	}
 }
 
+func TestUploadFileWithProgress(t *testing.T) {
+	mux, client := setup(t)
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 64*1024)
+
+	mux.HandleFunc("/api/v4/projects/1/uploads", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Failed to read uploaded file: %v", err)
+		}
+		uploaded, err := io.ReadAll(f)
+		if err != nil {
+			t.Fatalf("Failed to read uploaded file: %v", err)
+		}
+		if !bytes.Equal(content, uploaded) {
+			t.Errorf("Uploaded file has %d bytes, want %d", len(uploaded), len(content))
+		}
+		fmt.Fprint(w, `{"alt":"build","url":"/uploads/66dbcd21ec5d24ed6ea225176098d52b/build.log"}`)
+	})
+
+	var calls int
+	var total int64
+	_, _, err := client.Projects.UploadFile(1, bytes.NewReader(content), "build.log", WithUploadProgress(func(bytesSent int64) {
+		if bytesSent <= total {
+			t.Errorf("Progress went from %d to %d bytes, want it to increase", total, bytesSent)
+		}
+		calls++
+		total = bytesSent
+	}))
+	if err != nil {
+		t.Fatalf("Projects.UploadFile returns an error: %v", err)
+	}
+
+	if calls < 2 {
+		t.Errorf("Progress callback was called %d times, want multiple calls", calls)
+	}
+	if total != int64(len(content)) {
+		t.Errorf("Progress callback reported %d bytes, want the file size %d", total, len(content))
+	}
+}
+
 func TestUploadAvatar(t *testing.T) {
 	mux, client := setup(t)
 
@@ -589,14 +800,49 @@ func TestUploadAvatar(t *testing.T) {
 		if r.ContentLength == -1 {
 			t.Fatalf("Projects.UploadAvatar request content-length is -1")
 		}
-		fmt.Fprint(w, `{}`)
+		file, header, err := r.FormFile("avatar")
+		if err != nil {
+			t.Fatalf("Projects.UploadAvatar request has no avatar file: %v", err)
+		}
+		defer file.Close()
+		if header.Filename != "avatar.png" {
+			t.Errorf("Projects.UploadAvatar request filename is %q, want %q", header.Filename, "avatar.png")
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != "png-data" {
+			t.Errorf("Projects.UploadAvatar request content is %q, want %q", content, "png-data")
+		}
+		fmt.Fprint(w, `{"id": 1, "avatar_url": "http://example.com/uploads/project/avatar/1/avatar.png"}`)
 	})
 
-	avatar := new(bytes.Buffer)
-	_, _, err := client.Projects.UploadAvatar(1, avatar, "avatar.png")
+	avatar := bytes.NewBufferString("png-data")
+	project, _, err := client.Projects.UploadAvatar(1, avatar, "avatar.png")
 	if err != nil {
 		t.Fatalf("Projects.UploadAvatar returns an error: %v", err)
 	}
+
+	want := "http://example.com/uploads/project/avatar/1/avatar.png"
+	if project.AvatarURL != want {
+		t.Errorf("Projects.UploadAvatar returned avatar_url %q, want %q", project.AvatarURL, want)
+	}
+}
+
+func TestDeleteAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"avatar":""}`)
+		fmt.Fprint(w, `{"id": 1, "avatar_url": null}`)
+	})
+
+	project, _, err := client.Projects.DeleteAvatar(1)
+	if err != nil {
+		t.Fatalf("Projects.DeleteAvatar returns an error: %v", err)
+	}
+	if project.AvatarURL != "" {
+		t.Errorf("Projects.DeleteAvatar returned avatar_url %q, want empty", project.AvatarURL)
+	}
 }
 
 func TestUploadAvatar_Retry(t *testing.T) {
@@ -654,6 +900,90 @@ func TestListProjectForks(t *testing.T) {
 	}
 }
 
+func TestListProjectForksPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=2")
+		w.Header().Set("X-Page", "2")
+		w.Header().Set("X-Next-Page", "3")
+		w.Header().Set("X-Prev-Page", "1")
+		w.Header().Set("X-Total", "5")
+		w.Header().Set("X-Total-Pages", "3")
+		fmt.Fprint(w, `[{"id":3},{"id":4}]`)
+	})
+
+	opt := &ListProjectsOptions{ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	projects, resp, err := client.Projects.ListProjectForks(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.ListProjectForks returned error: %v", err)
+	}
+
+	want := []*Project{{ID: 3}, {ID: 4}}
+	if !reflect.DeepEqual(want, projects) {
+		t.Errorf("Projects.ListProjectForks returned %+v, want %+v", projects, want)
+	}
+
+	if resp.CurrentPage != 2 || resp.NextPage != 3 || resp.PreviousPage != 1 {
+		t.Errorf("Projects.ListProjectForks returned pages %d/%d/%d, want 1/2/3",
+			resp.PreviousPage, resp.CurrentPage, resp.NextPage)
+	}
+	if resp.TotalItems != 5 || resp.TotalPages != 3 {
+		t.Errorf("Projects.ListProjectForks returned %d items on %d pages, want 5 items on 3 pages",
+			resp.TotalItems, resp.TotalPages)
+	}
+}
+
+func TestCreateProjectForkRelation(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/fork/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+			"id": 10,
+			"forked_to_project_id": 2,
+			"forked_from_project_id": 1,
+			"created_at": "2017-06-29T12:00:49.594Z",
+			"updated_at": "2017-06-29T12:00:49.594Z"
+		}`)
+	})
+
+	rel, _, err := client.Projects.CreateProjectForkRelation(2, 1)
+	if err != nil {
+		t.Fatalf("Projects.CreateProjectForkRelation returned error: %v", err)
+	}
+
+	ts := time.Date(2017, 6, 29, 12, 0, 49, 594000000, time.UTC)
+	want := &ProjectForkRelation{
+		ID:                  10,
+		ForkedToProjectID:   2,
+		ForkedFromProjectID: 1,
+		CreatedAt:           &ts,
+		UpdatedAt:           &ts,
+	}
+	if !reflect.DeepEqual(want, rel) {
+		t.Errorf("Projects.CreateProjectForkRelation returned %+v, want %+v", rel, want)
+	}
+}
+
+func TestDeleteProjectForkRelation(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/fork", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Projects.DeleteProjectForkRelation(2)
+	if err != nil {
+		t.Fatalf("Projects.DeleteProjectForkRelation returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Projects.DeleteProjectForkRelation returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestDeleteProject(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1393,6 +1723,62 @@ func TestCreateProjectApprovalRule(t *testing.T) {
 	}
 }
 
+func TestStartMirroringProject(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/mirror/pull", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	resp, err := client.Projects.StartMirroringProject(1)
+	if err != nil {
+		t.Fatalf("Projects.StartMirroringProject returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Projects.StartMirroringProject returned status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestEditProjectPullMirrorOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"import_url":"https://example.com/upstream/project.git","mirror":true,"mirror_overwrites_diverged_branches":true,"mirror_trigger_builds":false,"only_mirror_protected_branches":true}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"import_url": "https://example.com/upstream/project.git",
+			"mirror": true,
+			"mirror_trigger_builds": false,
+			"only_mirror_protected_branches": true,
+			"mirror_overwrites_diverged_branches": true
+		}`)
+	})
+
+	project, _, err := client.Projects.EditProject(1, &EditProjectOptions{
+		ImportURL:                        Ptr("https://example.com/upstream/project.git"),
+		Mirror:                           Ptr(true),
+		MirrorTriggerBuilds:              Ptr(false),
+		OnlyMirrorProtectedBranches:      Ptr(true),
+		MirrorOverwritesDivergedBranches: Ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("Projects.EditProject returned error: %v", err)
+	}
+
+	want := &Project{
+		ID:                               1,
+		ImportURL:                        "https://example.com/upstream/project.git",
+		Mirror:                           true,
+		OnlyMirrorProtectedBranches:      true,
+		MirrorOverwritesDivergedBranches: true,
+	}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.EditProject returned %+v, want %+v", project, want)
+	}
+}
+
 func TestGetProjectPullMirrorDetails(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1798,6 +2184,52 @@ func TestAddProjectPushRules(t *testing.T) {
 	}
 }
 
+func TestEditProjectPushRulePartial(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		// Only the given fields are sent, including explicit false values.
+		testBody(t, r, `{"max_file_size":10,"prevent_secrets":false,"reject_unsigned_commits":true}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"project_id": 1,
+			"commit_message_regex": "Fixes \\d+\\..*",
+			"branch_name_regex": "(feat|fix)\\/*",
+			"member_check": true,
+			"prevent_secrets": false,
+			"max_file_size": 10,
+			"reject_unsigned_commits": true
+		}`)
+	})
+
+	opt := &EditProjectPushRuleOptions{
+		MaxFileSize:           Ptr(10),
+		PreventSecrets:        Ptr(false),
+		RejectUnsignedCommits: Ptr(true),
+	}
+
+	rule, _, err := client.Projects.EditProjectPushRule(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.EditProjectPushRule returned error: %v", err)
+	}
+
+	// Fields which were not edited keep their existing values.
+	want := &ProjectPushRules{
+		ID:                    1,
+		ProjectID:             1,
+		CommitMessageRegex:    "Fixes \\d+\\..*",
+		BranchNameRegex:       "(feat|fix)\\/*",
+		MemberCheck:           true,
+		PreventSecrets:        false,
+		MaxFileSize:           10,
+		RejectUnsignedCommits: true,
+	}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Projects.EditProjectPushRule returned %+v, want %+v", rule, want)
+	}
+}
+
 func TestEditProjectPushRules(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1954,3 +2386,104 @@ func TestDeleteProjectWebhookHeader(t *testing.T) {
 
 	assert.Equal(t, http.StatusNoContent, req.StatusCode)
 }
+
+func TestTransferProject(t *testing.T) {
+	mux, client := setup(t)
+
+	tests := []struct {
+		name      string
+		namespace interface{}
+		wantBody  string
+	}{
+		{
+			name:      "numeric namespace ID",
+			namespace: 42,
+			wantBody:  `{"namespace":42}`,
+		},
+		{
+			name:      "namespace path",
+			namespace: "new-group/sub-group",
+			wantBody:  `{"namespace":"new-group/sub-group"}`,
+		},
+	}
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/projects/1/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `{"id":1,"path_with_namespace":"new-group/sub-group/project","namespace":{"id":42,"full_path":"new-group/sub-group"}}`)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantBody = tt.wantBody
+
+			project, _, err := client.Projects.TransferProject(1, &TransferProjectOptions{Namespace: tt.namespace})
+			if err != nil {
+				t.Fatalf("Projects.TransferProject returned error: %v", err)
+			}
+
+			want := &Project{
+				ID:                1,
+				PathWithNamespace: "new-group/sub-group/project",
+				Namespace:         &ProjectNamespace{ID: 42, FullPath: "new-group/sub-group"},
+			}
+			if !reflect.DeepEqual(want, project) {
+				t.Errorf("Projects.TransferProject returned %+v, want %+v", project, want)
+			}
+		})
+	}
+}
+
+func TestStartHousekeeping(t *testing.T) {
+	mux, client := setup(t)
+
+	tests := []struct {
+		name       string
+		opt        *StartHousekeepingOptions
+		wantBody   string
+		statusCode int
+	}{
+		{
+			name:       "without task",
+			opt:        nil,
+			wantBody:   "null",
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "eager task",
+			opt:        &StartHousekeepingOptions{Task: Ptr("eager")},
+			wantBody:   `{"task":"eager"}`,
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "prune task",
+			opt:        &StartHousekeepingOptions{Task: Ptr("prune")},
+			wantBody:   `{"task":"prune"}`,
+			statusCode: http.StatusAccepted,
+		},
+	}
+
+	var wantBody string
+	var statusCode int
+	mux.HandleFunc("/api/v4/projects/1/housekeeping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		w.WriteHeader(statusCode)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantBody = tt.wantBody
+			statusCode = tt.statusCode
+
+			resp, err := client.Projects.StartHousekeeping(1, tt.opt)
+			if err != nil {
+				t.Fatalf("Projects.StartHousekeeping returned error: %v", err)
+			}
+			if resp.StatusCode != tt.statusCode {
+				t.Errorf("Projects.StartHousekeeping returned status %d, want %d", resp.StatusCode, tt.statusCode)
+			}
+		})
+	}
+}
@@ -242,3 +242,70 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", protectedBranch, want)
 	}
 }
+
+func TestProtectRepositoryBranchesWithUsersAndGroups(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"release/*",`+
+			`"allowed_to_push":[{"user_id":7},{"group_id":3}],`+
+			`"allowed_to_merge":[{"access_level":40},{"user_id":7}],`+
+			`"allowed_to_unprotect":[{"group_id":3}]}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "release/*",
+			"push_access_levels": [
+				{"id": 1, "access_level": 40, "user_id": 7, "access_level_description": "Administrator"},
+				{"id": 2, "access_level": 40, "group_id": 3, "access_level_description": "release-managers"}
+			]
+		}`)
+	})
+
+	opt := &ProtectRepositoryBranchesOptions{
+		Name: Ptr("release/*"),
+		AllowedToPush: &[]*BranchPermissionOptions{
+			{UserID: Ptr(7)},
+			{GroupID: Ptr(3)},
+		},
+		AllowedToMerge: &[]*BranchPermissionOptions{
+			{AccessLevel: Ptr(MaintainerPermissions)},
+			{UserID: Ptr(7)},
+		},
+		AllowedToUnprotect: &[]*BranchPermissionOptions{
+			{GroupID: Ptr(3)},
+		},
+	}
+	branch, _, err := client.ProtectedBranches.ProtectRepositoryBranches(1, opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.ProtectRepositoryBranches returned error: %v", err)
+	}
+
+	want := []*BranchAccessDescription{
+		{ID: 1, AccessLevel: MaintainerPermissions, UserID: 7, AccessLevelDescription: "Administrator"},
+		{ID: 2, AccessLevel: MaintainerPermissions, GroupID: 3, AccessLevelDescription: "release-managers"},
+	}
+	if !reflect.DeepEqual(want, branch.PushAccessLevels) {
+		t.Errorf("ProtectedBranches.ProtectRepositoryBranches returned %+v, want %+v", branch.PushAccessLevels, want)
+	}
+}
+
+func TestUpdateProtectedBranchRemovesPermission(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `{"allowed_to_push":[{"id":2,"_destroy":true},{"user_id":8}]}`)
+		fmt.Fprint(w, `{"name": "main"}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{
+		AllowedToPush: &[]*BranchPermissionOptions{
+			{ID: Ptr(2), Destroy: Ptr(true)},
+			{UserID: Ptr(8)},
+		},
+	}
+	if _, _, err := client.ProtectedBranches.UpdateProtectedBranch(1, "main", opt); err != nil {
+		t.Fatalf("ProtectedBranches.UpdateProtectedBranch returned error: %v", err)
+	}
+}
@@ -18,7 +18,9 @@ package gitlab
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 )
 
 // ReleaseLinksService handles communication with the release link methods
@@ -199,3 +201,62 @@ func (s *ReleaseLinksService) DeleteReleaseLink(pid interface{}, tagName string,
 
 	return rl, resp, nil
 }
+
+// DownloadReleaseAssetFile downloads a release asset using its direct asset
+// path. GitLab redirects the request to the URL of the asset, which is
+// followed automatically. The credentials of the client are not sent along
+// when the asset is hosted on another host. The resolved URL is available
+// through the request of the returned response. The asset is streamed and
+// the caller is responsible for closing the returned body once done reading.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/#download-a-release-asset
+func (s *ReleaseLinksService) DownloadReleaseAssetFile(pid interface{}, tagName, filePath string, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s/downloads/%s",
+		PathEscape(project),
+		PathEscape(tagName),
+		escapeAssetPath(filePath),
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.doStream(req)
+}
+
+// DownloadLatestReleaseAssetFile downloads a release asset of the latest
+// release using its direct asset path. See DownloadReleaseAssetFile for
+// details.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/#download-a-release-asset
+func (s *ReleaseLinksService) DownloadLatestReleaseAssetFile(pid interface{}, filePath string, options ...RequestOptionFunc) (io.ReadCloser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/permalink/latest/downloads/%s", PathEscape(project), escapeAssetPath(filePath))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.doStream(req)
+}
+
+// escapeAssetPath escapes every segment of the direct asset path of a release
+// link, so the path keeps its "/" separators.
+func escapeAssetPath(filePath string) string {
+	segments := strings.Split(strings.TrimPrefix(filePath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -18,7 +18,9 @@ package gitlab
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -194,3 +196,106 @@ func TestReleaseLinksService_DeleteReleaseLink(t *testing.T) {
 			releaseLink.Name)
 	}
 }
+
+func TestReleaseLinksService_DownloadReleaseAssetFile(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/downloads/bin/awesome-v0.1.dmg",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			http.Redirect(w, r, "/uploads/awesome-v0.1.dmg", http.StatusFound)
+		})
+	mux.HandleFunc("/api/v4/projects/1/releases/permalink/latest/downloads/bin/awesome-v0.1.dmg",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			http.Redirect(w, r, "/api/v4/projects/1/releases/v0.1/downloads/bin/awesome-v0.1.dmg", http.StatusFound)
+		})
+	mux.HandleFunc("/uploads/awesome-v0.1.dmg",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, "awesome binary")
+		})
+
+	body, resp, err := client.ReleaseLinks.DownloadReleaseAssetFile(1, "v0.1", "bin/awesome-v0.1.dmg")
+	require.NoError(t, err)
+	defer body.Close()
+
+	asset, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "awesome binary", string(asset))
+	assert.Equal(t, "/uploads/awesome-v0.1.dmg", resp.Request.URL.Path)
+
+	latest, resp, err := client.ReleaseLinks.DownloadLatestReleaseAssetFile(1, "bin/awesome-v0.1.dmg")
+	require.NoError(t, err)
+	defer latest.Close()
+
+	asset, err = io.ReadAll(latest)
+	require.NoError(t, err)
+	assert.Equal(t, "awesome binary", string(asset))
+	assert.Equal(t, "/uploads/awesome-v0.1.dmg", resp.Request.URL.Path)
+}
+
+func TestReleaseLinksService_DownloadReleaseAssetFileCrossHostRedirect(t *testing.T) {
+	mux, client := setup(t)
+
+	client, err := NewClient("secret-token", WithBaseURL(client.BaseURL().String()))
+	require.NoError(t, err)
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, header := range []string{"PRIVATE-TOKEN", "SUDO"} {
+			assert.Empty(t, r.Header.Get(header), "%s header forwarded to another host", header)
+		}
+		fmt.Fprint(w, "awesome binary")
+	}))
+	t.Cleanup(storage.Close)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/downloads/bin/awesome-v0.1.dmg",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			assert.Equal(t, "secret-token", r.Header.Get("PRIVATE-TOKEN"))
+			http.Redirect(w, r, storage.URL+"/awesome-v0.1.dmg", http.StatusFound)
+		})
+
+	body, resp, err := client.ReleaseLinks.DownloadReleaseAssetFile(1, "v0.1", "bin/awesome-v0.1.dmg", WithSudo("alice"))
+	require.NoError(t, err)
+	defer body.Close()
+
+	asset, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "awesome binary", string(asset))
+	assert.Equal(t, storage.URL+"/awesome-v0.1.dmg", resp.Request.URL.String())
+}
+
+func TestReleaseLinksService_DownloadReleaseAssetFileEscapesPath(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/downloads/",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testURL(t, r, "/api/v4/projects/1/releases/v0%2E1/downloads/bin/my%20app%231%2Edmg")
+			fmt.Fprint(w, "awesome binary")
+		})
+
+	body, _, err := client.ReleaseLinks.DownloadReleaseAssetFile(1, "v0.1", "/bin/my app#1.dmg")
+	require.NoError(t, err)
+	defer body.Close()
+
+	asset, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "awesome binary", string(asset))
+}
+
+func TestReleaseLinksService_DownloadReleaseAssetFileNotFound(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/downloads/missing.dmg",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			http.NotFound(w, r)
+		})
+
+	body, resp, err := client.ReleaseLinks.DownloadReleaseAssetFile(1, "v0.1", "missing.dmg")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, body)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
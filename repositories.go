@@ -405,7 +405,7 @@ func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt Generat
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/changelog", project)
+	u := fmt.Sprintf("projects/%s/repository/changelog", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
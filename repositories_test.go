@@ -443,3 +443,55 @@ func TestGenerateChangelogData(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, want, notes)
 }
+
+func TestGenerateChangelogDataWithVersionRange(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testURL(t, r, "/api/v4/projects/namespace%2Fname/repository/changelog?date=2021-11-17&from=v0.9.0&to=v1.0.0&trailer=Type&version=1.0.0")
+			testParams(t, r, "date=2021-11-17&from=v0.9.0&to=v1.0.0&trailer=Type&version=1.0.0")
+			fmt.Fprint(w, exampleChangelogResponse)
+		})
+
+	date, err := ParseISOTime("2021-11-17")
+	require.NoError(t, err)
+
+	notes, _, err := client.Repositories.GenerateChangelogData(
+		"namespace/name",
+		GenerateChangelogDataOptions{
+			Version: Ptr("1.0.0"),
+			From:    Ptr("v0.9.0"),
+			To:      Ptr("v1.0.0"),
+			Date:    &date,
+			Trailer: Ptr("Type"),
+		},
+	)
+	require.NoError(t, err)
+	assert.Contains(t, notes.Notes, "## 1.0.0 (2021-11-17)")
+}
+
+func TestAddChangelogWithVersionRange(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testURL(t, r, "/api/v4/projects/namespace%2Fname/repository/changelog")
+			testBody(t, r, `{"version":"1.0.0","branch":"main","config_file":".gitlab/changelog.yml","from":"v0.9.0","to":"v1.0.0"}`)
+			w.WriteHeader(http.StatusOK)
+		})
+
+	resp, err := client.Repositories.AddChangelog(
+		"namespace/name",
+		&AddChangelogOptions{
+			Version:    Ptr("1.0.0"),
+			Branch:     Ptr("main"),
+			ConfigFile: Ptr(".gitlab/changelog.yml"),
+			From:       Ptr("v0.9.0"),
+			To:         Ptr("v1.0.0"),
+		})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -19,6 +19,8 @@ package gitlab
 import (
 	"context"
 	"net/url"
+	"strconv"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -26,14 +28,54 @@ import (
 // RequestOptionFunc can be passed to all API requests to customize the API request.
 type RequestOptionFunc func(*retryablehttp.Request) error
 
-// WithContext runs the request with the provided context
+// maxPagesKey is the context key used to store the maximum number of pages
+// Scan and Collect are allowed to request.
+type maxPagesKey struct{}
+
+// withoutRetryKey is the context key used to mark a request which should not
+// be retried.
+type withoutRetryKey struct{}
+
+// requestTimeoutKey is the context key used to store the timeout of a
+// request, which is applied once the request is sent.
+type requestTimeoutKey struct{}
+
+// uploadProgressKey is the context key used to store the progress callback
+// of an upload.
+type uploadProgressKey struct{}
+
+// requestContextKeys are the context keys used by request options to store
+// their settings, which are kept when the context is replaced by WithContext.
+var requestContextKeys = []interface{}{maxPagesKey{}, withoutRetryKey{}, requestTimeoutKey{}, uploadProgressKey{}}
+
+// WithContext runs the request with the provided context. Settings of other
+// request options, like WithoutRetry or WithMaxPages, are kept regardless of
+// the order of the options.
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
+		for _, key := range requestContextKeys {
+			if v := req.Context().Value(key); v != nil {
+				ctx = context.WithValue(ctx, key, v)
+			}
+		}
 		*req = *req.WithContext(ctx)
 		return nil
 	}
 }
 
+// WithRequestTimeout runs the request with a timeout. The timeout starts when
+// the request is sent and is derived from the context of the request. When
+// passed more than once, the shortest timeout is used.
+func WithRequestTimeout(timeout time.Duration) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		if current, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration); ok && current < timeout {
+			return nil
+		}
+		*req = *req.WithContext(context.WithValue(req.Context(), requestTimeoutKey{}, timeout))
+		return nil
+	}
+}
+
 // WithHeader takes a header name and value and appends it to the request headers.
 func WithHeader(name, value string) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -53,6 +95,32 @@ func WithHeaders(headers map[string]string) RequestOptionFunc {
 	}
 }
 
+// WithIdempotencyKey sets the Idempotency-Key header of the request. The key
+// is sent unchanged with every attempt when the request is retried. When the
+// client is created using WithIdempotentRetries, requests with an idempotency
+// key are also retried on server errors, like GET requests.
+//
+// Note that GitLab does not document support for this header in its REST API,
+// so it only prevents duplicates when used with a proxy or gateway in front of
+// GitLab which honors it.
+func WithIdempotencyKey(key string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.Header.Set("Idempotency-Key", key)
+		return nil
+	}
+}
+
+// WithIfNoneMatch sets the If-None-Match header of the request to the given
+// ETag, as returned in the ETag field of a previous Response. When the
+// resource did not change, GitLab responds with 304 Not Modified, which is
+// indicated by the NotModified field of the Response.
+func WithIfNoneMatch(etag string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
 // WithKeysetPaginationParameters takes a "next" link from the Link header of a
 // response to a keyset-based paginated request and modifies the values of each
 // query parameter in the request with its corresponding response parameter.
@@ -74,6 +142,64 @@ func WithKeysetPaginationParameters(nextLink string) RequestOptionFunc {
 	}
 }
 
+// WithMaxPages limits the number of pages requested by Scan and Collect (and
+// the helpers built on them) to n. When more pages are available after the
+// last allowed page, ErrMaxPagesExceeded is returned. A limit of 0 removes
+// the limit set by an earlier WithMaxPages. It has no effect on single
+// requests.
+func WithMaxPages(n int) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), maxPagesKey{}, n))
+		return nil
+	}
+}
+
+// WithPage sets the page query parameter of the request to page, overriding
+// the Page of the ListOptions used to create the request. When used with Scan
+// or Collect it only affects the first page.
+func WithPage(page int) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// WithPerPage sets the per_page query parameter of the request to perPage,
+// overriding the PerPage of the ListOptions used to create the request. This
+// makes it possible to change the page size of a single call without changing
+// a shared options struct.
+func WithPerPage(perPage int) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("per_page", strconv.Itoa(perPage))
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// WithUploadProgress calls fn with the number of bytes of the uploaded file
+// sent so far, each time a part of the file is sent. It is used by uploads,
+// like UploadFile, to show progress of large uploads, and ignored by other
+// requests. When a request is retried the count starts at zero again.
+func WithUploadProgress(fn func(bytesSent int64)) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), uploadProgressKey{}, fn))
+		return nil
+	}
+}
+
+// WithoutRetry disables retries for a single request, regardless of the retry
+// policy of the client. Use it for requests which should never be sent twice,
+// like triggering a pipeline.
+func WithoutRetry() RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), withoutRetryKey{}, true))
+		return nil
+	}
+}
+
 // WithSudo takes either a username or user ID and sets the SUDO request header.
 func WithSudo(uid interface{}) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -14,6 +14,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -163,6 +164,62 @@ func TestWithHeaders(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestWithIdempotencyKey(t *testing.T) {
+	mux, client := setup(t)
+
+	var keys []string
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, _, err := client.Projects.CreateProject(
+		&CreateProjectOptions{Name: Ptr("test")},
+		WithIdempotencyKey("a5f1c0f8-6c2d-4a8e-9b1e-2f0c7d3e4b5a"),
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"a5f1c0f8-6c2d-4a8e-9b1e-2f0c7d3e4b5a",
+		"a5f1c0f8-6c2d-4a8e-9b1e-2f0c7d3e4b5a",
+		"a5f1c0f8-6c2d-4a8e-9b1e-2f0c7d3e4b5a",
+	}, keys)
+}
+
+func TestWithHeaderOverridesDefaultHeaders(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "application/vnd.custom+json", r.Header.Get("Accept"))
+		assert.Equal(t, "profile-token", r.Header.Get("X-Profile-Token"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "application/json; charset=utf-8", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.Header.Get("X-Profile-Token"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, _, err := client.Projects.GetProject(1, nil,
+		WithHeader("Accept", "application/vnd.custom+json"),
+		WithHeader("X-Profile-Token", "profile-token"),
+	)
+	assert.NoError(t, err)
+
+	_, _, err = client.Projects.CreateProject(&CreateProjectOptions{Name: Ptr("test")},
+		WithHeaders(map[string]string{"Content-Type": "application/json; charset=utf-8"}),
+	)
+	assert.NoError(t, err)
+}
+
 func TestWithKeysetPaginationParameters(t *testing.T) {
 	req, err := retryablehttp.NewRequest("GET", "https://gitlab.example.com/api/v4/groups?pagination=keyset&per_page=50&order_by=name&sort=asc", nil)
 	assert.NoError(t, err)
@@ -182,3 +239,181 @@ func TestWithKeysetPaginationParameters(t *testing.T) {
 	// Ensure cursor gets properly pulled from "next link" header
 	assert.Equal(t, "eyJuYW1lIjoiRmxpZ2h0anMiLCJpZCI6IjI2IiwiX2tkIjoibiJ9", values.Get("cursor"))
 }
+
+func TestWithPageAndPerPage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=3&per_page=100&search=foo")
+		fmt.Fprint(w, `[]`)
+	})
+
+	opt := &ListProjectsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 20},
+		Search:      Ptr("foo"),
+	}
+
+	_, _, err := client.Projects.ListProjects(opt, WithPage(3), WithPerPage(100))
+	assert.NoError(t, err)
+
+	// The shared options struct is not modified.
+	assert.Equal(t, ListOptions{Page: 1, PerPage: 20}, opt.ListOptions)
+}
+
+func TestWithPerPageWithoutListOptions(t *testing.T) {
+	req, err := retryablehttp.NewRequest("GET", "https://gitlab.example.com/api/v4/groups?order_by=name", nil)
+	assert.NoError(t, err)
+
+	err = WithPerPage(100)(req)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "order_by=name&per_page=100", req.URL.RawQuery)
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "slow", nil, []RequestOptionFunc{
+		WithRequestTimeout(50 * time.Millisecond),
+	})
+	assert.NoError(t, err)
+
+	// The deadline is only attached once the request is sent.
+	_, deadlineSet := req.Context().Deadline()
+	assert.False(t, deadlineSet)
+
+	_, err = client.Do(req, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithRequestTimeoutDerivesFromContext(t *testing.T) {
+	mux, client := setup(t)
+
+	type ctxKey struct{}
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+	defer cancel()
+
+	var sentCtx context.Context
+	_, _, err := client.Projects.ListProjects(nil,
+		WithRequestTimeout(time.Minute),
+		WithContext(ctx),
+		func(req *retryablehttp.Request) error {
+			sentCtx = req.Context()
+			return nil
+		},
+	)
+	assert.NoError(t, err)
+
+	// The timeout is kept when WithContext is passed after it.
+	assert.Equal(t, time.Minute, sentCtx.Value(requestTimeoutKey{}))
+	assert.Equal(t, "value", sentCtx.Value(ctxKey{}))
+}
+
+func TestWithRequestTimeoutUsesShortestTimeout(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects", nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, WithRequestTimeout(time.Second)(req))
+	assert.NoError(t, WithRequestTimeout(time.Minute)(req))
+	assert.Equal(t, time.Second, req.Context().Value(requestTimeoutKey{}))
+
+	assert.NoError(t, WithRequestTimeout(time.Millisecond)(req))
+	assert.Equal(t, time.Millisecond, req.Context().Value(requestTimeoutKey{}))
+}
+
+func TestWithoutRetry(t *testing.T) {
+	mux, client := setup(t)
+
+	var triggers, lists int
+	mux.HandleFunc("/api/v4/projects/1/trigger/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		triggers++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		lists++
+		if lists < 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, resp, err := client.PipelineTriggers.RunPipelineTrigger(1, &RunPipelineTriggerOptions{
+		Ref:   Ptr("main"),
+		Token: Ptr("trigger-token"),
+	}, WithoutRetry())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, 1, triggers)
+
+	_, _, err = client.Pipelines.ListProjectPipelines(1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lists)
+}
+
+func TestWithContextKeepsRequestOptions(t *testing.T) {
+	mux, client := setup(t)
+
+	var attempts int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	requests := handleThreePages(t, mux, "/api/v4/projects")
+
+	for name, options := range map[string][]RequestOptionFunc{
+		"context first": {WithContext(context.Background()), WithoutRetry(), WithMaxPages(2)},
+		"context last":  {WithoutRetry(), WithMaxPages(2), WithContext(context.Background())},
+	} {
+		t.Run(name, func(t *testing.T) {
+			attempts, *requests = 0, 0
+
+			_, _, err := client.Projects.GetProject(1, nil, options...)
+			assert.Error(t, err)
+			assert.Equal(t, 1, attempts)
+
+			_, err = client.Projects.CollectProjects(nil, options...)
+			assert.ErrorIs(t, err, ErrMaxPagesExceeded)
+			assert.Equal(t, 2, *requests)
+		})
+	}
+}
+
+func TestWithoutRetryCustomRetryPolicy(t *testing.T) {
+	mux, client := setup(t)
+
+	client, err := NewClient("",
+		WithBaseURL(client.BaseURL().String()),
+		WithCustomRetry(func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			return resp != nil && resp.StatusCode == http.StatusConflict, err
+		}),
+		WithCustomBackoff(func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return 0
+		}),
+	)
+	assert.NoError(t, err)
+
+	var attempts int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, _, err = client.Projects.GetProject(1, nil, WithoutRetry())
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
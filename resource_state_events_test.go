@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -181,3 +182,70 @@ func TestResourceStateEventsService_GetMergeRequestStateEvent(t *testing.T) {
 	}
 	require.Equal(t, want, se)
 }
+
+func TestResourceStateEventsService_ListIssueStateEventsSequence(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/issues/11/resource_state_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+		  {"id": 142, "user": {"id": 1, "username": "root"}, "created_at": "2024-03-01T10:00:00.000Z", "resource_type": "Issue", "resource_id": 11, "state": "closed"},
+		  {"id": 143, "user": {"id": 2, "username": "jdoe"}, "created_at": "2024-03-02T11:30:00.000Z", "resource_type": "Issue", "resource_id": 11, "state": "reopened"},
+		  {"id": 144, "user": {"id": 1, "username": "root"}, "created_at": "2024-03-05T09:15:00.000Z", "resource_type": "Issue", "resource_id": 11, "state": "closed"}
+		]`)
+	})
+
+	ses, _, err := client.ResourceStateEvents.ListIssueStateEvents(5, 11, nil)
+	require.NoError(t, err)
+
+	want := []*StateEvent{
+		{
+			ID:           142,
+			User:         &BasicUser{ID: 1, Username: "root"},
+			CreatedAt:    Ptr(time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)),
+			ResourceType: "Issue",
+			ResourceID:   11,
+			State:        ClosedEventType,
+		},
+		{
+			ID:           143,
+			User:         &BasicUser{ID: 2, Username: "jdoe"},
+			CreatedAt:    Ptr(time.Date(2024, time.March, 2, 11, 30, 0, 0, time.UTC)),
+			ResourceType: "Issue",
+			ResourceID:   11,
+			State:        ReopenedEventType,
+		},
+		{
+			ID:           144,
+			User:         &BasicUser{ID: 1, Username: "root"},
+			CreatedAt:    Ptr(time.Date(2024, time.March, 5, 9, 15, 0, 0, time.UTC)),
+			ResourceType: "Issue",
+			ResourceID:   11,
+			State:        ClosedEventType,
+		},
+	}
+	require.Equal(t, want, ses)
+}
+
+func TestResourceStateEventsService_ListMergeStateEventsMerged(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/resource_state_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+		  {"id": 150, "user": {"id": 1, "username": "root"}, "resource_type": "MergeRequest", "resource_id": 11, "state": "closed"},
+		  {"id": 151, "user": {"id": 1, "username": "root"}, "resource_type": "MergeRequest", "resource_id": 11, "state": "reopened"},
+		  {"id": 152, "user": {"id": 1, "username": "root"}, "resource_type": "MergeRequest", "resource_id": 11, "state": "merged"}
+		]`)
+	})
+
+	ses, _, err := client.ResourceStateEvents.ListMergeStateEvents(5, 11, nil)
+	require.NoError(t, err)
+
+	states := make([]EventTypeValue, 0, len(ses))
+	for _, se := range ses {
+		require.Equal(t, "MergeRequest", se.ResourceType)
+		states = append(states, se.State)
+	}
+	require.Equal(t, []EventTypeValue{ClosedEventType, ReopenedEventType, MergedEventType}, states)
+}
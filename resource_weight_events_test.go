@@ -9,7 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestResourceWeightEventsService_ListIssueWightEvents(t *testing.T) {
+func TestResourceWeightEventsService_ListIssueWeightEvents(t *testing.T) {
 	mux, client := setup(t)
 
 	mux.HandleFunc("/api/v4/projects/5/issues/11/resource_weight_events", func(w http.ResponseWriter, r *http.Request) {
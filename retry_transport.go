@@ -0,0 +1,105 @@
+//
+// Copyright 2024, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"net/http"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryConfig configures the retry logic of a transport created with
+// NewRetryTransport. Fields which are not set use the same defaults as the
+// Client.
+type RetryConfig struct {
+	// RetryMax is the maximum number of retries, so a request is attempted
+	// at most RetryMax+1 times. Defaults to 5. Use a negative value to
+	// disable retries.
+	RetryMax int
+
+	// RetryWaitMin and RetryWaitMax bound the time to wait between attempts,
+	// which grows linearly with every attempt. Rate limited responses wait at
+	// least as long as their RateLimit-Reset or Retry-After header says.
+	// Defaults to 100ms and 400ms.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// CheckRetry decides whether a request should be retried. Defaults to
+	// retrying rate limit (429) and server (>= 500) errors.
+	CheckRetry retryablehttp.CheckRetry
+
+	// Backoff determines the time to wait between attempts. Defaults to
+	// honoring the RateLimit-Reset and Retry-After headers of rate limited
+	// responses, and a linear jitter backoff bounded by RetryWaitMin and
+	// RetryWaitMax otherwise.
+	Backoff retryablehttp.Backoff
+}
+
+// NewRetryTransport returns a http.RoundTripper which wraps base with the
+// same retry and backoff logic as used by the Client. When base is nil,
+// http.DefaultTransport is used. The transport can be used with any
+// http.Client, also for requests which are not made to GitLab.
+//
+// When the maximum number of retries is reached, the last response is
+// returned as is, so it can be inspected by the caller.
+func NewRetryTransport(base http.RoundTripper, cfg RetryConfig) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	c := &retryablehttp.Client{
+		Backoff:      cfg.Backoff,
+		CheckRetry:   cfg.CheckRetry,
+		ErrorHandler: retryablehttp.PassthroughErrorHandler,
+		HTTPClient:   &http.Client{Transport: base},
+		RetryWaitMin: cfg.RetryWaitMin,
+		RetryWaitMax: cfg.RetryWaitMax,
+		RetryMax:     cfg.RetryMax,
+	}
+
+	if c.Backoff == nil {
+		c.Backoff = retryTransportBackoff
+	}
+	if c.CheckRetry == nil {
+		c.CheckRetry = retryHTTPCheck
+	}
+	if c.RetryWaitMin == 0 {
+		c.RetryWaitMin = defaultRetryWaitMin
+	}
+	if c.RetryWaitMax == 0 {
+		c.RetryWaitMax = defaultRetryWaitMax
+	}
+	switch {
+	case c.RetryMax == 0:
+		c.RetryMax = defaultRetryMax
+	case c.RetryMax < 0:
+		c.RetryMax = 0
+	}
+
+	return &retryablehttp.RoundTripper{Client: c}
+}
+
+// retryTransportBackoff provides the default callback for the Backoff of a
+// transport created with NewRetryTransport. Unlike retryHTTPBackoff, it uses
+// the configured bounds for server errors as well.
+func retryTransportBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if waitForRetryHeaders(resp) {
+		return rateLimitBackoff(min, max, attemptNum, resp)
+	}
+	return retryablehttp.LinearJitterBackoff(min, max, attemptNum, resp)
+}
@@ -314,7 +314,10 @@ func (s *SearchService) UsersByProject(pid interface{}, query string, opt *Searc
 }
 
 func (s *SearchService) search(scope, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := &searchOptions{Scope: scope, Search: query}
+	if opt != nil {
+		opts.SearchOptions = *opt
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, "search", opts, options)
 	if err != nil {
@@ -331,7 +334,10 @@ func (s *SearchService) searchByGroup(gid interface{}, scope, query string, resu
 	}
 	u := fmt.Sprintf("groups/%s/-/search", PathEscape(group))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := &searchOptions{Scope: scope, Search: query}
+	if opt != nil {
+		opts.SearchOptions = *opt
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
@@ -348,7 +354,10 @@ func (s *SearchService) searchByProject(pid interface{}, scope, query string, re
 	}
 	u := fmt.Sprintf("projects/%s/-/search", PathEscape(project))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := &searchOptions{Scope: scope, Search: query}
+	if opt != nil {
+		opts.SearchOptions = *opt
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
@@ -17,8 +17,10 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +96,144 @@ func TestSearchService_UsersByProject(t *testing.T) {
 	}}
 	require.Equal(t, want, users)
 }
+
+func TestSearchService_Blobs(t *testing.T) {
+	mux, client := setup(t)
+
+	want := []*Blob{{
+		Basename:  "README",
+		Data:      "```\n\n## Installation\n\nQuick start using the [pre-built\n",
+		Path:      "README.md",
+		Filename:  "README.md",
+		Ref:       "main",
+		Startline: 46,
+		ProjectID: 6,
+	}}
+
+	for _, path := range []string{"/api/v4/search", "/api/v4/groups/3/-/search", "/api/v4/projects/6/-/search"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "ref=main&scope=blobs&search=installation")
+			mustWriteHTTPResponse(t, w, "testdata/search_blobs.json")
+		})
+	}
+
+	opts := &SearchOptions{Ref: Ptr("main")}
+
+	blobs, _, err := client.Search.Blobs("installation", opts)
+	require.NoError(t, err)
+	require.Equal(t, want, blobs)
+
+	blobs, _, err = client.Search.BlobsByGroup(3, "installation", opts)
+	require.NoError(t, err)
+	require.Equal(t, want, blobs)
+
+	blobs, _, err = client.Search.BlobsByProject(6, "installation", opts)
+	require.NoError(t, err)
+	require.Equal(t, want, blobs)
+}
+
+func TestSearchService_Commits(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/6/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=commits&search=bye")
+		mustWriteHTTPResponse(t, w, "testdata/search_commits.json")
+	})
+
+	commits, _, err := client.Search.CommitsByProject(6, "bye", nil)
+	require.NoError(t, err)
+
+	date := time.Date(2017, 9, 5, 19, 45, 18, 0, time.UTC)
+	want := []*Commit{{
+		ID:             "4109c2d872d5fdb1ed057400d103766aaea97f98",
+		ShortID:        "4109c2d8",
+		Title:          "goal: add a test",
+		CreatedAt:      &date,
+		ParentIDs:      []string{"59d05353ab575bcc2aa958fe1782e93297de64c9"},
+		Message:        "goal: add a test\n",
+		AuthorName:     "Darby Frey",
+		AuthorEmail:    "darbyfrey@gmail.com",
+		AuthoredDate:   &date,
+		CommitterName:  "Darby Frey",
+		CommitterEmail: "darbyfrey@gmail.com",
+		CommittedDate:  &date,
+		ProjectID:      6,
+	}}
+	require.Equal(t, want, commits)
+}
+
+func TestSearchService_Projects(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=projects&search=flight")
+		fmt.Fprint(w, `[{"id":6,"name":"Flight","path_with_namespace":"twitter/flight","default_branch":"main"}]`)
+	})
+
+	projects, _, err := client.Search.Projects("flight", nil)
+	require.NoError(t, err)
+
+	want := []*Project{{ID: 6, Name: "Flight", PathWithNamespace: "twitter/flight", DefaultBranch: "main"}}
+	require.Equal(t, want, projects)
+}
+
+func TestSearchService_Issues(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/3/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=issues&search=file")
+		fmt.Fprint(w, `[{"id":83,"iid":1,"project_id":12,"title":"Add file","state":"opened","labels":["bug"]}]`)
+	})
+
+	issues, _, err := client.Search.IssuesByGroup(3, "file", nil)
+	require.NoError(t, err)
+
+	want := []*Issue{{ID: 83, IID: 1, ProjectID: 12, Title: "Add file", State: "opened", Labels: Labels{"bug"}}}
+	require.Equal(t, want, issues)
+}
+
+func TestSearchService_MergeRequests(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/6/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=merge_requests&search=file")
+		fmt.Fprint(w, `[{"id":56,"iid":8,"project_id":6,"title":"Add first file","source_branch":"feature","target_branch":"main","state":"opened"}]`)
+	})
+
+	mrs, _, err := client.Search.MergeRequestsByProject(6, "file", nil)
+	require.NoError(t, err)
+
+	require.Len(t, mrs, 1)
+	require.Equal(t, 56, mrs[0].ID)
+	require.Equal(t, 8, mrs[0].IID)
+	require.Equal(t, 6, mrs[0].ProjectID)
+	require.Equal(t, "Add first file", mrs[0].Title)
+	require.Equal(t, "feature", mrs[0].SourceBranch)
+	require.Equal(t, "main", mrs[0].TargetBranch)
+}
+
+func TestSearchService_NotesByProject(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/6/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "scope=notes&search=readme")
+		mustWriteHTTPResponse(t, w, "testdata/search_notes.json")
+	})
+
+	notes, _, err := client.Search.NotesByProject(6, "readme", nil)
+	require.NoError(t, err)
+
+	require.Len(t, notes, 1)
+	require.Equal(t, 191, notes[0].ID)
+	require.Equal(t, "Did you read the project's README?", notes[0].Body)
+	require.Equal(t, "user1", notes[0].Author.Username)
+	require.Equal(t, "Issue", notes[0].NoteableType)
+	require.Equal(t, 22, notes[0].NoteableID)
+	require.Equal(t, 2, notes[0].NoteableIID)
+}
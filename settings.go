@@ -74,6 +74,7 @@ type Settings struct {
 	CheckNamespacePlan                                    bool                      `json:"check_namespace_plan"`
 	CIMaxIncludes                                         int                       `json:"ci_max_includes"`
 	CIMaxTotalYAMLSizeBytes                               int                       `json:"ci_max_total_yaml_size_bytes"`
+	CodeSuggestionsAPIRateLimit                           int                       `json:"code_suggestions_api_rate_limit"`
 	CommitEmailHostname                                   string                    `json:"commit_email_hostname"`
 	ConcurrentBitbucketImportJobsLimit                    int                       `json:"concurrent_bitbucket_import_jobs_limit"`
 	ConcurrentBitbucketServerImportJobsLimit              int                       `json:"concurrent_bitbucket_server_import_jobs_limit"`
@@ -233,6 +234,7 @@ type Settings struct {
 	InactiveProjectsSendWarningEmailAfterMonths           int                       `json:"inactive_projects_send_warning_email_after_months"`
 	IncludeOptionalMetricsInServicePing                   bool                      `json:"include_optional_metrics_in_service_ping"`
 	InProductMarketingEmailsEnabled                       bool                      `json:"in_product_marketing_emails_enabled"`
+	InstanceLevelAIBetaFeaturesEnabled                    bool                      `json:"instance_level_ai_beta_features_enabled"`
 	InvisibleCaptchaEnabled                               bool                      `json:"invisible_captcha_enabled"`
 	IssuesCreateLimit                                     int                       `json:"issues_create_limit"`
 	JiraConnectApplicationKey                             string                    `json:"jira_connect_application_key"`
@@ -548,6 +550,7 @@ type UpdateSettingsOptions struct {
 	CheckNamespacePlan                                    *bool                            `url:"check_namespace_plan,omitempty" json:"check_namespace_plan,omitempty"`
 	CIMaxIncludes                                         *int                             `url:"ci_max_includes,omitempty" json:"ci_max_includes,omitempty"`
 	CIMaxTotalYAMLSizeBytes                               *int                             `url:"ci_max_total_yaml_size_bytes,omitempty" json:"ci_max_total_yaml_size_bytes,omitempty"`
+	CodeSuggestionsAPIRateLimit                           *int                             `url:"code_suggestions_api_rate_limit,omitempty" json:"code_suggestions_api_rate_limit,omitempty"`
 	CommitEmailHostname                                   *string                          `url:"commit_email_hostname,omitempty" json:"commit_email_hostname,omitempty"`
 	ConcurrentBitbucketImportJobsLimit                    *int                             `url:"concurrent_bitbucket_import_jobs_limit,omitempty" json:"concurrent_bitbucket_import_jobs_limit,omitempty"`
 	ConcurrentBitbucketServerImportJobsLimit              *int                             `url:"concurrent_bitbucket_server_import_jobs_limit,omitempty" json:"concurrent_bitbucket_server_import_jobs_limit,omitempty"`
@@ -706,6 +709,7 @@ type UpdateSettingsOptions struct {
 	InactiveProjectsSendWarningEmailAfterMonths           *int                             `url:"inactive_projects_send_warning_email_after_months,omitempty" json:"inactive_projects_send_warning_email_after_months,omitempty"`
 	IncludeOptionalMetricsInServicePing                   *bool                            `url:"include_optional_metrics_in_service_ping,omitempty" json:"include_optional_metrics_in_service_ping,omitempty"`
 	InProductMarketingEmailsEnabled                       *bool                            `url:"in_product_marketing_emails_enabled,omitempty" json:"in_product_marketing_emails_enabled,omitempty"`
+	InstanceLevelAIBetaFeaturesEnabled                    *bool                            `url:"instance_level_ai_beta_features_enabled,omitempty" json:"instance_level_ai_beta_features_enabled,omitempty"`
 	InvisibleCaptchaEnabled                               *bool                            `url:"invisible_captcha_enabled,omitempty" json:"invisible_captcha_enabled,omitempty"`
 	IssuesCreateLimit                                     *int                             `url:"issues_create_limit,omitempty" json:"issues_create_limit,omitempty"`
 	JiraConnectApplicationKey                             *string                          `url:"jira_connect_application_key,omitempty" json:"jira_connect_application_key,omitempty"`
@@ -64,6 +64,52 @@ func TestUpdateSettings(t *testing.T) {
 	}
 }
 
+func TestUpdateSettingsDuoFeatures(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"duo_features_enabled":false,"instance_level_ai_beta_features_enabled":true,"lock_duo_features_enabled":true}`)
+		fmt.Fprint(w, `{"duo_features_enabled": false, "instance_level_ai_beta_features_enabled": true, "lock_duo_features_enabled": true}`)
+	})
+
+	options := &UpdateSettingsOptions{
+		DuoFeaturesEnabled:                 Ptr(false),
+		InstanceLevelAIBetaFeaturesEnabled: Ptr(true),
+		LockDuoFeaturesEnabled:             Ptr(true),
+	}
+	settings, _, err := client.Settings.UpdateSettings(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Settings{InstanceLevelAIBetaFeaturesEnabled: true, LockDuoFeaturesEnabled: true}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Settings.UpdateSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateSettingsOmitsUnsetFields(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"code_suggestions_api_rate_limit":60}`)
+		fmt.Fprint(w, `{"code_suggestions_api_rate_limit": 60}`)
+	})
+
+	settings, _, err := client.Settings.UpdateSettings(&UpdateSettingsOptions{
+		CodeSuggestionsAPIRateLimit: Ptr(60),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.CodeSuggestionsAPIRateLimit != 60 {
+		t.Errorf("Settings.UpdateSettings returned code_suggestions_api_rate_limit %d, want %d", settings.CodeSuggestionsAPIRateLimit, 60)
+	}
+}
+
 func TestSettingsWithEmptyContainerRegistry(t *testing.T) {
 	mux, client := setup(t)
 
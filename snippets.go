@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -137,7 +138,7 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...RequestOptionFu
 // https://docs.gitlab.com/ee/api/snippets.html#snippet-repository-file-content
 func (s *SnippetsService) SnippetFileContent(snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
 	filepath := PathEscape(filename)
-	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, ref, filepath)
+	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, url.PathEscape(ref), filepath)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
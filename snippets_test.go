@@ -146,3 +146,17 @@ func TestSnippetsService_ListAllSnippets(t *testing.T) {
 	want := []*Snippet{{ID: 113, Title: "Internal Snippet"}, {ID: 114, Title: "Private Snippet"}}
 	require.Equal(t, want, ss)
 }
+
+func TestSnippetsService_SnippetFileContent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/snippets/1/files/a1b2c3d4/docs/README.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/snippets/1/files/a1b2c3d4/docs%2FREADME%2Emd/raw")
+		fmt.Fprint(w, "# Hello World")
+	})
+
+	b, _, err := client.Snippets.SnippetFileContent(1, "a1b2c3d4", "docs/README.md")
+	require.NoError(t, err)
+	require.Equal(t, []byte("# Hello World"), b)
+}
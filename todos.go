@@ -112,6 +112,7 @@ type ListTodosOptions struct {
 	Action    *TodoAction `url:"action,omitempty" json:"action,omitempty"`
 	AuthorID  *int        `url:"author_id,omitempty" json:"author_id,omitempty"`
 	ProjectID *int        `url:"project_id,omitempty" json:"project_id,omitempty"`
+	GroupID   *int        `url:"group_id,omitempty" json:"group_id,omitempty"`
 	State     *string     `url:"state,omitempty" json:"state,omitempty"`
 	Type      *string     `url:"type,omitempty" json:"type,omitempty"`
 }
@@ -150,6 +151,22 @@ func (s *TodosService) MarkTodoAsDone(id int, options ...RequestOptionFunc) (*Re
 	return s.client.Do(req, nil)
 }
 
+// MarkTodosAsDone marks multiple pending todos given by their IDs for the
+// current user as done. As the GitLab API has no bulk endpoint for this, every
+// todo is marked with an individual MarkTodoAsDone call, using a bounded
+// number of concurrent requests.
+//
+// When one or more todos could not be marked as done a *BulkError keyed by
+// todo ID is returned. A todo ID given more than once is only marked once.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/todos.html#mark-a-to-do-item-as-done
+func (s *TodosService) MarkTodosAsDone(ids []int, options ...RequestOptionFunc) error {
+	_, err := bulkDo(ids, "mark todos as done", func(id int) (*Response, error) {
+		return s.MarkTodoAsDone(id, options...)
+	})
+	return err
+}
+
 // MarkAllTodosAsDone marks all pending todos for the current user as done.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/todos.html#mark-all-to-do-items-as-done
@@ -17,7 +17,10 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -65,3 +68,62 @@ func TestMarkTodoAsDone(t *testing.T) {
 	_, err := client.Todos.MarkTodoAsDone(1)
 	require.NoError(t, err)
 }
+
+func TestListTodosFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/todos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "action=assigned&author_id=2&group_id=3&project_id=4&state=done&type=MergeRequest")
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Todos.ListTodos(&ListTodosOptions{
+		Action:    Ptr(TodoAssigned),
+		AuthorID:  Ptr(2),
+		GroupID:   Ptr(3),
+		ProjectID: Ptr(4),
+		State:     Ptr("done"),
+		Type:      Ptr("MergeRequest"),
+	})
+	require.NoError(t, err)
+}
+
+func TestMarkTodosAsDone(t *testing.T) {
+	mux, client := setup(t)
+
+	var mu sync.Mutex
+	var marked []int
+	mux.HandleFunc("/api/v4/todos/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var id int
+		_, err := fmt.Sscanf(r.URL.Path, "/api/v4/todos/%d/mark_as_done", &id)
+		require.NoError(t, err)
+
+		if id == 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		marked = append(marked, id)
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := client.Todos.MarkTodosAsDone([]int{1, 2, 3, 4, 2, 3})
+
+	var markErr *BulkError
+	require.ErrorAs(t, err, &markErr)
+	require.Len(t, markErr.Errors, 1)
+	require.ErrorIs(t, markErr.Errors[3], ErrNotFound)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Len(t, markErr.Unwrap(), 1)
+
+	// Todos given more than once are only marked once.
+	sort.Ints(marked)
+	require.Equal(t, []int{1, 2, 4}, marked)
+
+	require.NoError(t, client.Todos.MarkTodosAsDone(nil))
+}
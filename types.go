@@ -33,6 +33,16 @@ func Ptr[T any](v T) *T {
 	return &v
 }
 
+// Value is a helper that returns the value p points to, or the zero value
+// of T if p is nil.
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
 // AccessControlValue represents an access control value within GitLab,
 // used for managing access to certain project features.
 //
@@ -81,6 +91,42 @@ const (
 	OwnerPermission AccessLevelValue = 50
 )
 
+// String returns the name of the access level, as shown in the GitLab UI.
+func (a AccessLevelValue) String() string {
+	switch a {
+	case NoPermissions:
+		return "No access"
+	case MinimalAccessPermissions:
+		return "Minimal access"
+	case GuestPermissions:
+		return "Guest"
+	case ReporterPermissions:
+		return "Reporter"
+	case DeveloperPermissions:
+		return "Developer"
+	case MaintainerPermissions:
+		return "Maintainer"
+	case OwnerPermissions:
+		return "Owner"
+	case AdminPermissions:
+		return "Admin"
+	default:
+		return fmt.Sprintf("AccessLevelValue(%d)", int(a))
+	}
+}
+
+// EncodeValues implements the query.Encoder interface. Access levels are
+// encoded as their numeric value, instead of the name returned by String. A
+// nil access level is encoded as an empty value.
+func (a *AccessLevelValue) EncodeValues(key string, v *url.Values) error {
+	if a == nil {
+		v.Set(key, "")
+		return nil
+	}
+	v.Set(key, strconv.Itoa(int(*a)))
+	return nil
+}
+
 // AccessLevel is a helper routine that allocates a new AccessLevelValue
 // to store v and returns a pointer to it.
 //
@@ -247,12 +293,54 @@ const (
 	Running            BuildStateValue = "running"
 	Success            BuildStateValue = "success"
 	Failed             BuildStateValue = "failed"
+	Canceling          BuildStateValue = "canceling"
 	Canceled           BuildStateValue = "canceled"
 	Skipped            BuildStateValue = "skipped"
 	Manual             BuildStateValue = "manual"
 	Scheduled          BuildStateValue = "scheduled"
 )
 
+// Valid reports whether s is one of the known build states.
+func (s BuildStateValue) Valid() bool {
+	switch s {
+	case Created, WaitingForResource, Preparing, Pending, Running, Success,
+		Failed, Canceling, Canceled, Skipped, Manual, Scheduled:
+		return true
+	}
+	return false
+}
+
+// PipelineStatusValue represents a GitLab pipeline status.
+type PipelineStatusValue string
+
+// These constants represent all valid pipeline statuses.
+const (
+	PipelineStatusCreated            PipelineStatusValue = "created"
+	PipelineStatusWaitingForResource PipelineStatusValue = "waiting_for_resource"
+	PipelineStatusPreparing          PipelineStatusValue = "preparing"
+	PipelineStatusPending            PipelineStatusValue = "pending"
+	PipelineStatusRunning            PipelineStatusValue = "running"
+	PipelineStatusSuccess            PipelineStatusValue = "success"
+	PipelineStatusFailed             PipelineStatusValue = "failed"
+	PipelineStatusCanceling          PipelineStatusValue = "canceling"
+	PipelineStatusCanceled           PipelineStatusValue = "canceled"
+	PipelineStatusSkipped            PipelineStatusValue = "skipped"
+	PipelineStatusManual             PipelineStatusValue = "manual"
+	PipelineStatusScheduled          PipelineStatusValue = "scheduled"
+)
+
+// Valid reports whether s is one of the known pipeline statuses.
+func (s PipelineStatusValue) Valid() bool {
+	switch s {
+	case PipelineStatusCreated, PipelineStatusWaitingForResource, PipelineStatusPreparing,
+		PipelineStatusPending, PipelineStatusRunning, PipelineStatusSuccess, PipelineStatusFailed,
+		PipelineStatusCanceling, PipelineStatusCanceled, PipelineStatusSkipped,
+		PipelineStatusManual, PipelineStatusScheduled:
+		return true
+	}
+	return false
+}
+
 // BuildState is a helper routine that allocates a new BuildStateValue
 // to store v and returns a pointer to it.
 //
@@ -18,9 +18,44 @@ package gitlab
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+
+	"github.com/google/go-querystring/query"
 )
 
+func TestValue(t *testing.T) {
+	if got := Value(Ptr("foo")); got != "foo" {
+		t.Errorf("Value returned %q, want %q", got, "foo")
+	}
+	if got := Value(Ptr(42)); got != 42 {
+		t.Errorf("Value returned %d, want %d", got, 42)
+	}
+	if got := Value(Ptr(PrivateVisibility)); got != PrivateVisibility {
+		t.Errorf("Value returned %q, want %q", got, PrivateVisibility)
+	}
+
+	t.Run("should return the zero value for nil pointers", func(t *testing.T) {
+		if got := Value[string](nil); got != "" {
+			t.Errorf("Value returned %q, want empty string", got)
+		}
+		if got := Value[int](nil); got != 0 {
+			t.Errorf("Value returned %d, want 0", got)
+		}
+		if got := Value[bool](nil); got {
+			t.Errorf("Value returned %v, want false", got)
+		}
+		if got := Value[*Project](nil); got != nil {
+			t.Errorf("Value returned %v, want nil", got)
+		}
+
+		var opt ListProjectsOptions
+		if got := Value(opt.MinAccessLevel); got != 0 {
+			t.Errorf("Value returned %d, want 0", got)
+		}
+	})
+}
+
 func TestBoolValue(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -72,3 +107,133 @@ func TestBoolValue(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineStatusValue(t *testing.T) {
+	testCases := []struct {
+		name  string
+		data  []byte
+		want  PipelineStatusValue
+		valid bool
+	}{
+		{
+			name:  "should unmarshal a known status",
+			data:  []byte(`{"id":1,"status":"waiting_for_resource"}`),
+			want:  PipelineStatusWaitingForResource,
+			valid: true,
+		},
+		{
+			name:  "should unmarshal an unknown status",
+			data:  []byte(`{"id":1,"status":"some_new_status"}`),
+			want:  PipelineStatusValue("some_new_status"),
+			valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var p Pipeline
+			if err := json.Unmarshal(testCase.data, &p); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if p.Status != testCase.want {
+				t.Fatalf("Expected %q but got %q", testCase.want, p.Status)
+			}
+			if p.Status.Valid() != testCase.valid {
+				t.Fatalf("Expected Valid() to return %v for %q", testCase.valid, p.Status)
+			}
+
+			data, err := json.Marshal(PipelineInfo{ID: p.ID, Status: p.Status})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var info PipelineInfo
+			if err := json.Unmarshal(data, &info); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if info.Status != testCase.want {
+				t.Fatalf("Expected %q after round-trip but got %q", testCase.want, info.Status)
+			}
+		})
+	}
+}
+
+func TestAccessLevelValueString(t *testing.T) {
+	testCases := []struct {
+		level AccessLevelValue
+		want  string
+	}{
+		{NoPermissions, "No access"},
+		{MinimalAccessPermissions, "Minimal access"},
+		{GuestPermissions, "Guest"},
+		{ReporterPermissions, "Reporter"},
+		{DeveloperPermissions, "Developer"},
+		{MaintainerPermissions, "Maintainer"},
+		{OwnerPermissions, "Owner"},
+		{AdminPermissions, "Admin"},
+		{AccessLevelValue(15), "AccessLevelValue(15)"},
+		{AccessLevelValue(-1), "AccessLevelValue(-1)"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.want, func(t *testing.T) {
+			if got := testCase.level.String(); got != testCase.want {
+				t.Fatalf("Expected %q but got %q", testCase.want, got)
+			}
+			if got := fmt.Sprint(testCase.level); got != testCase.want {
+				t.Fatalf("Expected %q but got %q", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccessLevelValueEncoding(t *testing.T) {
+	opt := &ListProjectsOptions{MinAccessLevel: Ptr(DeveloperPermissions)}
+
+	q, err := query.Values(opt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := q.Encode(); got != "min_access_level=30" {
+		t.Fatalf("Expected %q but got %q", "min_access_level=30", got)
+	}
+
+	b, err := json.Marshal(opt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := string(b); got != `{"min_access_level":30}` {
+		t.Fatalf("Expected %q but got %q", `{"min_access_level":30}`, got)
+	}
+}
+
+func TestAccessLevelValueSliceEncoding(t *testing.T) {
+	// Broadcast message options are sent as JSON, which encodes the access
+	// levels as their numeric value.
+	opt := &CreateBroadcastMessageOptions{
+		Message:            Ptr("Maintenance tonight"),
+		TargetAccessLevels: []AccessLevelValue{DeveloperPermissions, MaintainerPermissions},
+	}
+
+	b, err := json.Marshal(opt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := string(b); got != `{"message":"Maintenance tonight","target_access_levels":[30,40]}` {
+		t.Fatalf("Expected %q but got %q", `{"message":"Maintenance tonight","target_access_levels":[30,40]}`, got)
+	}
+}
+
+func TestAccessLevelValueEncodingNil(t *testing.T) {
+	// GroupAccess has no omitempty, so a nil value is still encoded.
+	opt := &ShareWithGroupOptions{GroupID: Ptr(1)}
+
+	q, err := query.Values(opt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := q.Encode(); got != "expires_at=&group_access=&group_id=1" {
+		t.Fatalf("Expected %q but got %q", "expires_at=&group_access=&group_id=1", got)
+	}
+}
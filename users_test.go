@@ -686,6 +686,62 @@ func TestCreateUserRunner(t *testing.T) {
 	require.Equal(t, (*time.Time)(nil), response.TokenExpiresAt)
 }
 
+func TestCreateUserRunnerPerRunnerType(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *CreateUserRunnerOptions
+		wantBody string
+	}{
+		{
+			name: "instance_type",
+			opts: &CreateUserRunnerOptions{
+				RunnerType:  Ptr("instance_type"),
+				Description: Ptr("shared runner"),
+				RunUntagged: Ptr(true),
+			},
+			wantBody: `{"runner_type":"instance_type","description":"shared runner","run_untagged":true}`,
+		},
+		{
+			name: "group_type",
+			opts: &CreateUserRunnerOptions{
+				RunnerType: Ptr("group_type"),
+				GroupID:    Ptr(42),
+				TagList:    &[]string{"docker", "linux"},
+			},
+			wantBody: `{"runner_type":"group_type","group_id":42,"tag_list":["docker","linux"]}`,
+		},
+		{
+			name: "project_type",
+			opts: &CreateUserRunnerOptions{
+				RunnerType: Ptr("project_type"),
+				ProjectID:  Ptr(1),
+				Locked:     Ptr(true),
+			},
+			wantBody: `{"runner_type":"project_type","project_id":1,"locked":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, client := setup(t)
+
+			mux.HandleFunc("/api/v4/user/runners", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPost)
+				testBody(t, r, tt.wantBody)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id":1234,"token":"glrt-1234567890ABCD","token_expires_at":"2024-06-01T00:00:00Z"}`)
+			})
+
+			runner, _, err := client.Users.CreateUserRunner(tt.opts)
+			require.NoError(t, err)
+
+			expiresAt := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+			want := &UserRunner{ID: 1234, Token: "glrt-1234567890ABCD", TokenExpiresAt: &expiresAt}
+			require.Equal(t, want, runner)
+		})
+	}
+}
+
 func TestCreatePersonalAccessTokenForCurrentUser(t *testing.T) {
 	mux, client := setup(t)
 
@@ -274,3 +274,56 @@ func TestValidateProjectLint(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProjectLintWithIncludes(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/ci/lint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "dry_run=true&ref=main")
+		fmt.Fprint(w, `{
+			"valid": true,
+			"errors": [],
+			"warnings": [],
+			"merged_yaml": "---\nbuild:\n  script:\n  - echo build\ntest:\n  script:\n  - echo test\n",
+			"includes": [
+				{
+					"type": "local",
+					"location": "ci/test.yml",
+					"blob": "https://gitlab.example.com/group/project/-/blob/e3a0c0ea/ci/test.yml",
+					"raw": "https://gitlab.example.com/group/project/-/raw/e3a0c0ea/ci/test.yml",
+					"extra": {},
+					"context_project": "group/project",
+					"context_sha": "e3a0c0ea"
+				}
+			]
+		}`)
+	})
+
+	got, _, err := client.Validate.ProjectLint(1, &ProjectLintOptions{
+		DryRun: Ptr(true),
+		Ref:    Ptr("main"),
+	})
+	if err != nil {
+		t.Fatalf("Validate.ProjectLint returned error: %v", err)
+	}
+
+	want := &ProjectLintResult{
+		Valid:      true,
+		Errors:     []string{},
+		Warnings:   []string{},
+		MergedYaml: "---\nbuild:\n  script:\n  - echo build\ntest:\n  script:\n  - echo test\n",
+		Includes: []*LintInclude{{
+			Type:           "local",
+			Location:       "ci/test.yml",
+			Blob:           "https://gitlab.example.com/group/project/-/blob/e3a0c0ea/ci/test.yml",
+			Raw:            "https://gitlab.example.com/group/project/-/raw/e3a0c0ea/ci/test.yml",
+			Extra:          map[string]interface{}{},
+			ContextProject: "group/project",
+			ContextSHA:     "e3a0c0ea",
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate.ProjectLint returned \ngot:\n%v\nwant:\n%v", Stringify(got), Stringify(want))
+	}
+}
@@ -17,6 +17,7 @@ package gitlab
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -202,3 +203,70 @@ func (s *WikisService) DeleteWikiPage(pid interface{}, slug string, options ...R
 
 	return s.client.Do(req, nil)
 }
+
+// WikiAttachment represents a GitLab wiki attachment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/wikis.html#upload-an-attachment-to-the-wiki-repository
+type WikiAttachment struct {
+	FileName string             `json:"file_name"`
+	FilePath string             `json:"file_path"`
+	Branch   string             `json:"branch"`
+	Link     WikiAttachmentLink `json:"link"`
+}
+
+// WikiAttachmentLink represents a GitLab wiki attachment link.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/wikis.html#upload-an-attachment-to-the-wiki-repository
+type WikiAttachmentLink struct {
+	URL      string `json:"url"`
+	Markdown string `json:"markdown"`
+}
+
+func (w WikiAttachment) String() string {
+	return Stringify(w)
+}
+
+// UploadWikiAttachmentOptions represents the available UploadWikiAttachment
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/wikis.html#upload-an-attachment-to-the-wiki-repository
+type UploadWikiAttachmentOptions struct {
+	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+}
+
+// UploadWikiAttachment uploads a file to the attachment folder inside the
+// wiki's repository. The attachment folder is the uploads folder.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/wikis.html#upload-an-attachment-to-the-wiki-repository
+func (s *WikisService) UploadWikiAttachment(pid interface{}, content io.Reader, filename string, opt *UploadWikiAttachmentOptions, options ...RequestOptionFunc) (*WikiAttachment, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/wikis/attachments", PathEscape(project))
+
+	req, err := s.client.UploadRequest(
+		http.MethodPost,
+		u,
+		content,
+		filename,
+		UploadFile,
+		opt,
+		options,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wa := new(WikiAttachment)
+	resp, err := s.client.Do(req, wa)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wa, resp, nil
+}
@@ -16,7 +16,9 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"testing"
@@ -194,3 +196,58 @@ func TestDeleteWikiPage(t *testing.T) {
 		t.Errorf("Wiki.DeleteWikiPage returned error: %v", err)
 	}
 }
+
+func TestUploadWikiAttachment(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/wikis/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Wikis.UploadWikiAttachment request is not multipart encoded: %v", err)
+		}
+		if got := r.FormValue("branch"); got != "main" {
+			t.Errorf("Wikis.UploadWikiAttachment request branch is %q, want %q", got, "main")
+		}
+
+		f, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Wikis.UploadWikiAttachment request has no file: %v", err)
+		}
+		defer f.Close()
+
+		content, _ := io.ReadAll(f)
+		if header.Filename != "dk.png" || string(content) != "dummy" {
+			t.Errorf("Wikis.UploadWikiAttachment request file is %q with %q, want %q with %q", header.Filename, content, "dk.png", "dummy")
+		}
+
+		fmt.Fprint(w, `{
+			"file_name": "dk.png",
+			"file_path": "uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png",
+			"branch": "main",
+			"link": {
+				"url": "uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png",
+				"markdown": "![dk](uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png)"
+			}
+		}`)
+	})
+
+	opt := &UploadWikiAttachmentOptions{Branch: Ptr("main")}
+	attachment, _, err := client.Wikis.UploadWikiAttachment(1, bytes.NewBufferString("dummy"), "dk.png", opt)
+	if err != nil {
+		t.Fatalf("Wikis.UploadWikiAttachment returned error: %v", err)
+	}
+
+	want := &WikiAttachment{
+		FileName: "dk.png",
+		FilePath: "uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png",
+		Branch:   "main",
+		Link: WikiAttachmentLink{
+			URL:      "uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png",
+			Markdown: "![dk](uploads/6a061c4cf9f1c28cb22c384b4b8d4e3c/dk.png)",
+		},
+	}
+	if !reflect.DeepEqual(want, attachment) {
+		t.Errorf("Wikis.UploadWikiAttachment returned %+v, want %+v", attachment, want)
+	}
+}